@@ -382,6 +382,18 @@ func (tx *AccountAllowanceApproveTransaction) Execute(client *Client) (Transacti
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the AccountAllowanceApproveTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *AccountAllowanceApproveTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed AccountAllowanceApproveTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *AccountAllowanceApproveTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *AccountAllowanceApproveTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
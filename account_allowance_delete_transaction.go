@@ -262,6 +262,18 @@ func (tx *AccountAllowanceDeleteTransaction) Execute(client *Client) (Transactio
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the AccountAllowanceDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *AccountAllowanceDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed AccountAllowanceDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *AccountAllowanceDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *AccountAllowanceDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -108,6 +108,8 @@ func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	q.pb = q.buildQuery()
 
+	// the answer reflects consensus state no newer than the moment the query was sent
+	requested := time.Now()
 	resp, err := _Execute(client, q)
 
 	if err != nil {
@@ -117,7 +119,7 @@ func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
 	protobufResponse := resp.(*services.Response).GetCryptogetAccountBalance()
 	balance := _AccountBalanceFromProtobuf(protobufResponse)
 
-	err = fetchTokenBalances(fetchMirrorNodeUrlFromClient(client), fmt.Sprint(protobufResponse.GetAccountID().GetAccountNum()), &balance)
+	err = fetchTokenBalances(MirrorClientFromClient(client), fmt.Sprint(protobufResponse.GetAccountID().GetAccountNum()), requested, &balance)
 
 	if err != nil {
 		return balance, err
@@ -133,11 +135,12 @@ populates them in the appropriate AccountBalance tokens field.
 IMPORTANT: This function will fetch the state of the data in the Mirror Node at the moment of its execution. It
 is important to note that the Mirror Node currently needs 2-3 seconds to be updated with the latest data from the
 consensus nodes. So if data related to token relationships is changed and a proper timeout is not introduced the
-user would not get the up to date state of token relationships. This note is ONLY for token relationship data as it
+user would not get the up to date state of token relationships. When mirror polling is enabled, the mirror node is
+first given time to ingest consensus up to the given timestamp. This note is ONLY for token relationship data as it
 is queried from the MirrorNode. Other query information arrives at the time of consensus response.
 */
-func fetchTokenBalances(network string, id string, balance *AccountBalance) error {
-	response, err := accountTokenBalanceMirrorNodeQuery(network, id)
+func fetchTokenBalances(mirror *MirrorClient, id string, consensusTimestamp time.Time, balance *AccountBalance) error {
+	response, err := accountTokenBalanceMirrorNodeQuery(mirror, id, consensusTimestamp)
 	if err != nil {
 		return err
 	}
@@ -394,6 +394,18 @@ func (tx *AccountCreateTransaction) Execute(client *Client) (TransactionResponse
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the AccountCreateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *AccountCreateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed AccountCreateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *AccountCreateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *AccountCreateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -220,6 +220,18 @@ func (tx *AccountDeleteTransaction) Execute(client *Client) (TransactionResponse
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the AccountDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *AccountDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed AccountDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *AccountDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *AccountDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
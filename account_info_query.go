@@ -51,6 +51,8 @@ func (q *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
+	// the answer reflects consensus state no newer than the moment the query was sent
+	requested := time.Now()
 	resp, err := q.execute(client, q)
 
 	if err != nil {
@@ -63,7 +65,7 @@ func (q *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 		return AccountInfo{}, err
 	}
 
-	err = fetchAccountInfoTokenRelationships(fetchMirrorNodeUrlFromClient(client), fmt.Sprint(protobufResponse.AccountID.GetAccountNum()), &info)
+	err = fetchAccountInfoTokenRelationships(MirrorClientFromClient(client), fmt.Sprint(protobufResponse.AccountID.GetAccountNum()), requested, &info)
 
 	if err != nil {
 		return info, err
@@ -79,11 +81,12 @@ relationships and populates the appropriate field in AccountInfo object
 IMPORTANT: This function will fetch the state of the data in the Mirror Node at the moment of its execution. It
 is important to note that the Mirror Node currently needs 2-3 seconds to be updated with the latest data from the
 consensus nodes. So if data related to token relationships is changed and a proper timeout is not introduced the
-user would not get the up to date state of token relationships. This note is ONLY for token relationship data as it
+user would not get the up to date state of token relationships. When mirror polling is enabled, the mirror node is
+first given time to ingest consensus up to the given timestamp. This note is ONLY for token relationship data as it
 is queried from the MirrorNode. Other query information arrives at the time of consensus response.
 */
-func fetchAccountInfoTokenRelationships(network string, id string, info *AccountInfo) error {
-	response, err := tokenRelationshipMirrorNodeQuery(network, id)
+func fetchAccountInfoTokenRelationships(mirror *MirrorClient, id string, consensusTimestamp time.Time, info *AccountInfo) error {
+	response, err := tokenRelationshipMirrorNodeQuery(mirror, id, consensusTimestamp)
 	if err != nil {
 		return err
 	}
@@ -413,6 +413,18 @@ func (tx *AccountUpdateTransaction) Execute(client *Client) (TransactionResponse
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the AccountUpdateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *AccountUpdateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed AccountUpdateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *AccountUpdateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *AccountUpdateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -0,0 +1,365 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"container/list"
+	"context"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// AliasResolver resolves account aliases and EVM addresses to entity numbers, and back, through the mirror node.
+// Results are kept in a least recently used cache. Aliases the mirror node doesn't know, and hollow accounts, which
+// still get their key, are cached for a shorter time.
+type AliasResolver struct {
+	mirror      *MirrorClient
+	capacity    int
+	ttl         time.Duration
+	negativeTTL time.Duration
+	concurrency int
+	entries     map[string]*list.Element
+	recent      *list.List
+	mu          sync.Mutex
+}
+
+type _AliasEntry struct {
+	key        string
+	found      bool
+	accountID  AccountID
+	contractID ContractID
+	evmAddress []byte
+	expires    time.Time
+}
+
+// NewAliasResolver creates an AliasResolver using the mirror node of client
+func NewAliasResolver(client *Client) *AliasResolver {
+	return &AliasResolver{
+		mirror:      MirrorClientFromClient(client),
+		capacity:    10000,
+		ttl:         time.Hour,
+		negativeTTL: 30 * time.Second,
+		concurrency: 8,
+		entries:     make(map[string]*list.Element),
+		recent:      list.New(),
+	}
+}
+
+// SetCacheSize sets the number of results kept, dropping the least recently used ones beyond it. Defaults to 10000.
+func (resolver *AliasResolver) SetCacheSize(size int) *AliasResolver {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	resolver.capacity = size
+	resolver._Evict()
+	return resolver
+}
+
+// GetCacheSize returns the number of results kept
+func (resolver *AliasResolver) GetCacheSize() int {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	return resolver.capacity
+}
+
+// SetTTL sets how long a resolved alias is kept. Defaults to an hour.
+func (resolver *AliasResolver) SetTTL(ttl time.Duration) *AliasResolver {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	resolver.ttl = ttl
+	return resolver
+}
+
+// GetTTL returns how long a resolved alias is kept
+func (resolver *AliasResolver) GetTTL() time.Duration {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	return resolver.ttl
+}
+
+// SetNegativeTTL sets how long an alias without an account, or of a hollow account, is kept. Defaults to 30 seconds.
+func (resolver *AliasResolver) SetNegativeTTL(ttl time.Duration) *AliasResolver {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	resolver.negativeTTL = ttl
+	return resolver
+}
+
+// GetNegativeTTL returns how long an alias without an account, or of a hollow account, is kept
+func (resolver *AliasResolver) GetNegativeTTL() time.Duration {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	return resolver.negativeTTL
+}
+
+// SetConcurrency sets the number of requests ResolveAccountIDs sends to the mirror node at once. Defaults to 8.
+func (resolver *AliasResolver) SetConcurrency(concurrency int) *AliasResolver {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	resolver.concurrency = concurrency
+	return resolver
+}
+
+// GetConcurrency returns the number of requests ResolveAccountIDs sends to the mirror node at once
+func (resolver *AliasResolver) GetConcurrency() int {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	return resolver.concurrency
+}
+
+// Clear drops every cached result
+func (resolver *AliasResolver) Clear() {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	resolver.entries = make(map[string]*list.Element)
+	resolver.recent.Init()
+}
+
+// ResolveAccountID returns the account with the alias key or EVM address of accountID by its number. An accountID
+// without an alias is returned as it is. It returns ErrAliasNotFound if there is no account with the alias.
+func (resolver *AliasResolver) ResolveAccountID(ctx context.Context, accountID AccountID) (AccountID, error) {
+	if accountID.AliasKey == nil && accountID.AliasEvmAddress == nil {
+		return accountID, nil
+	}
+
+	entry, err := resolver._ResolveAccount(ctx, accountID)
+	if err != nil {
+		return AccountID{}, err
+	}
+	if !entry.found {
+		return AccountID{}, ErrAliasNotFound{Alias: accountID.String()}
+	}
+
+	return entry.accountID, nil
+}
+
+// ResolveAccountIDs resolves every account of accountIDs like ResolveAccountID, sending the requests for aliases
+// missing from the cache concurrently. Accounts without an account for their alias are returned as they are, as
+// transferring hbar to such an alias creates the account.
+func (resolver *AliasResolver) ResolveAccountIDs(ctx context.Context, accountIDs []AccountID) ([]AccountID, error) {
+	resolved := make([]AccountID, len(accountIDs))
+	errs := make([]error, len(accountIDs))
+
+	concurrency := resolver.GetConcurrency()
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// Aliases appearing more than once are only looked up once
+	first := make(map[string]int)
+	var wait sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+
+	for i, accountID := range accountIDs {
+		if accountID.AliasKey == nil && accountID.AliasEvmAddress == nil {
+			resolved[i] = accountID
+			continue
+		}
+
+		key := accountID.String()
+		if _, ok := first[key]; ok {
+			continue
+		}
+		first[key] = i
+
+		wait.Add(1)
+		slots <- struct{}{}
+		go func(i int, accountID AccountID) {
+			defer wait.Done()
+			defer func() { <-slots }()
+
+			resolved[i], errs[i] = resolver.ResolveAccountID(ctx, accountID)
+			if errors.As(errs[i], &ErrAliasNotFound{}) {
+				resolved[i], errs[i] = accountID, nil
+			}
+		}(i, accountID)
+	}
+
+	wait.Wait()
+
+	for i, accountID := range accountIDs {
+		if accountID.AliasKey == nil && accountID.AliasEvmAddress == nil {
+			continue
+		}
+
+		j := first[accountID.String()]
+		if errs[j] != nil {
+			return nil, errs[j]
+		}
+		resolved[i] = resolved[j]
+	}
+
+	return resolved, nil
+}
+
+// ResolveEvmAddress returns the EVM address of the account, which is its EVM address alias if it has one. It returns
+// ErrAliasNotFound if there is no such account.
+func (resolver *AliasResolver) ResolveEvmAddress(ctx context.Context, accountID AccountID) ([]byte, error) {
+	if accountID.AliasEvmAddress != nil {
+		return *accountID.AliasEvmAddress, nil
+	}
+
+	entry, err := resolver._ResolveAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.found {
+		return nil, ErrAliasNotFound{Alias: accountID.String()}
+	}
+
+	return entry.evmAddress, nil
+}
+
+// ResolveContractID returns the contract with the EVM address of contractID by its number. A contractID without an
+// EVM address is returned as it is. It returns ErrAliasNotFound if there is no contract with the EVM address.
+func (resolver *AliasResolver) ResolveContractID(ctx context.Context, contractID ContractID) (ContractID, error) {
+	if contractID.EvmAddress == nil {
+		return contractID, nil
+	}
+
+	address := hex.EncodeToString(contractID.EvmAddress)
+	entry, err := resolver._Lookup("contract:"+address, func() (_AliasEntry, error) {
+		contract, err := resolver.mirror.GetContract(ctx, address)
+		if err != nil {
+			return _AliasEntry{}, err
+		}
+
+		resolved, err := contract.GetContractID()
+		if err != nil {
+			return _AliasEntry{}, err
+		}
+
+		return _AliasEntry{found: true, contractID: resolved, expires: time.Now().Add(resolver.GetTTL())}, nil
+	})
+	if err != nil {
+		return ContractID{}, err
+	}
+	if !entry.found {
+		return ContractID{}, ErrAliasNotFound{Alias: contractID.String()}
+	}
+
+	return entry.contractID, nil
+}
+
+func (resolver *AliasResolver) _ResolveAccount(ctx context.Context, accountID AccountID) (_AliasEntry, error) {
+	var path string
+	switch {
+	case accountID.AliasEvmAddress != nil:
+		path = hex.EncodeToString(*accountID.AliasEvmAddress)
+	case accountID.AliasKey != nil:
+		data, err := protobuf.Marshal(accountID.AliasKey._ToProtoKey())
+		if err != nil {
+			return _AliasEntry{}, err
+		}
+		path = fmt.Sprintf("%d.%d.%s", accountID.Shard, accountID.Realm, base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data))
+	default:
+		path = fmt.Sprintf("%d.%d.%d", accountID.Shard, accountID.Realm, accountID.Account)
+	}
+
+	return resolver._Lookup("account:"+path, func() (_AliasEntry, error) {
+		account, err := resolver.mirror.GetAccount(ctx, path)
+		if err != nil {
+			return _AliasEntry{}, err
+		}
+
+		resolved, err := account.GetAccountID()
+		if err != nil {
+			return _AliasEntry{}, err
+		}
+
+		evmAddress, err := hex.DecodeString(strings.TrimPrefix(account.EvmAddress, "0x"))
+		if err != nil {
+			return _AliasEntry{}, err
+		}
+
+		entry := _AliasEntry{found: true, accountID: resolved, evmAddress: evmAddress, expires: time.Now().Add(resolver.GetTTL())}
+		if account.Key == nil {
+			// A hollow account gets its key once it signs a transaction
+			entry.expires = time.Now().Add(resolver.GetNegativeTTL())
+		}
+
+		return entry, nil
+	})
+}
+
+// _Lookup returns the cached entry for key, or the one fetch returns. A not found response of the mirror node is
+// cached as an entry which wasn't found.
+func (resolver *AliasResolver) _Lookup(key string, fetch func() (_AliasEntry, error)) (_AliasEntry, error) {
+	resolver.mu.Lock()
+	if element, ok := resolver.entries[key]; ok {
+		entry := element.Value.(*_AliasEntry)
+		if time.Now().Before(entry.expires) {
+			resolver.recent.MoveToFront(element)
+			resolver.mu.Unlock()
+			return *entry, nil
+		}
+
+		resolver.recent.Remove(element)
+		delete(resolver.entries, key)
+	}
+	resolver.mu.Unlock()
+
+	entry, err := fetch()
+	if err != nil {
+		var responseErr ErrMirrorNodeResponse
+		if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusNotFound {
+			return _AliasEntry{}, err
+		}
+
+		entry = _AliasEntry{found: false, expires: time.Now().Add(resolver.GetNegativeTTL())}
+	}
+	entry.key = key
+
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	if element, ok := resolver.entries[key]; ok {
+		resolver.recent.Remove(element)
+	}
+	resolver.entries[key] = resolver.recent.PushFront(&entry)
+	resolver._Evict()
+
+	return entry, nil
+}
+
+func (resolver *AliasResolver) _Evict() {
+	for resolver.capacity > 0 && resolver.recent.Len() > resolver.capacity {
+		oldest := resolver.recent.Back()
+		resolver.recent.Remove(oldest)
+		delete(resolver.entries, oldest.Value.(*_AliasEntry).key)
+	}
+}
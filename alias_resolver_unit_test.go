@@ -0,0 +1,251 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAliasEvmAddress = "00000000000000000000000000000000000003e8"
+
+func _MockAliasMirror(t *testing.T, requests *int32) (*Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/accounts/" + testAliasEvmAddress:
+			_, _ = w.Write([]byte(`{"account":"0.0.10","evm_address":"0x` + testAliasEvmAddress + `","key":{"_type":"ECDSA_SECP256K1","key":"02aa"}}`))
+		case "/api/v1/accounts/0.0.11":
+			_, _ = w.Write([]byte(`{"account":"0.0.11","evm_address":"0x000000000000000000000000000000000000000b","key":null}`))
+		case "/api/v1/contracts/" + testAliasEvmAddress:
+			_, _ = w.Write([]byte(`{"contract_id":"0.0.12","evm_address":"0x` + testAliasEvmAddress + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"Not found"}]}}`))
+		}
+	}))
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	return client, server.Close
+}
+
+func _TestEvmAccountID(t *testing.T, address string) AccountID {
+	accountID, err := AccountIDFromEvmAddress(0, 0, address)
+	require.NoError(t, err)
+	return accountID
+}
+
+func TestUnitAliasResolverResolveAccountID(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	resolver := NewAliasResolver(client)
+	alias := _TestEvmAccountID(t, testAliasEvmAddress)
+
+	for i := 0; i < 3; i++ {
+		accountID, err := resolver.ResolveAccountID(context.Background(), alias)
+		require.NoError(t, err)
+		assert.Equal(t, AccountID{Account: 10}, accountID)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	accountID, err := resolver.ResolveAccountID(context.Background(), AccountID{Account: 3})
+	require.NoError(t, err)
+	assert.Equal(t, AccountID{Account: 3}, accountID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestUnitAliasResolverNegativeCache(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	resolver := NewAliasResolver(client)
+	alias := _TestEvmAccountID(t, strings.Repeat("ab", 20))
+
+	for i := 0; i < 2; i++ {
+		_, err := resolver.ResolveAccountID(context.Background(), alias)
+		require.ErrorIs(t, err, ErrAliasNotFound{Alias: alias.String()})
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Without a negative TTL the alias is looked up again
+	resolver.Clear()
+	resolver.SetNegativeTTL(0)
+	for i := 0; i < 2; i++ {
+		_, err := resolver.ResolveAccountID(context.Background(), alias)
+		require.Error(t, err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestUnitAliasResolverEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	resolver := NewAliasResolver(client).SetCacheSize(1)
+	alias := _TestEvmAccountID(t, testAliasEvmAddress)
+
+	_, err := resolver.ResolveAccountID(context.Background(), alias)
+	require.NoError(t, err)
+	evmAddress, err := resolver.ResolveEvmAddress(context.Background(), AccountID{Account: 11})
+	require.NoError(t, err)
+	assert.Equal(t, "000000000000000000000000000000000000000b", hex.EncodeToString(evmAddress))
+
+	_, err = resolver.ResolveAccountID(context.Background(), alias)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	assert.Equal(t, 1, resolver.recent.Len())
+}
+
+func TestUnitAliasResolverResolveAccountIDs(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	alias := _TestEvmAccountID(t, testAliasEvmAddress)
+	unknown := _TestEvmAccountID(t, strings.Repeat("ab", 20))
+
+	resolved, err := NewAliasResolver(client).
+		ResolveAccountIDs(context.Background(), []AccountID{alias, {Account: 3}, unknown, alias})
+	require.NoError(t, err)
+	require.Len(t, resolved, 4)
+	assert.Equal(t, AccountID{Account: 10}, resolved[0])
+	assert.Equal(t, AccountID{Account: 3}, resolved[1])
+	assert.Equal(t, unknown, resolved[2])
+	assert.Equal(t, AccountID{Account: 10}, resolved[3])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestUnitAliasResolverConcurrentConfiguration(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	resolver := NewAliasResolver(client)
+	alias := _TestEvmAccountID(t, testAliasEvmAddress)
+
+	var wait sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wait.Add(2)
+		go func() {
+			defer wait.Done()
+			resolver.Clear()
+			_, err := resolver.ResolveAccountIDs(context.Background(), []AccountID{alias})
+			assert.NoError(t, err)
+		}()
+		go func(i int) {
+			defer wait.Done()
+			resolver.SetTTL(time.Duration(i+1) * time.Minute).
+				SetNegativeTTL(time.Duration(i+1) * time.Second).
+				SetConcurrency(i + 1)
+		}(i)
+	}
+	wait.Wait()
+}
+
+func TestUnitAliasResolverResolveContractID(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	contractID, err := ContractIDFromEvmAddress(0, 0, testAliasEvmAddress)
+	require.NoError(t, err)
+
+	resolved, err := NewAliasResolver(client).ResolveContractID(context.Background(), contractID)
+	require.NoError(t, err)
+	assert.Equal(t, ContractID{Contract: 12}, resolved)
+}
+
+func TestUnitTransferTransactionResolvesAliases(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	client, closeServer := _MockAliasMirror(t, &requests)
+	defer closeServer()
+
+	alias := _TestEvmAccountID(t, testAliasEvmAddress)
+	unknown := _TestEvmAccountID(t, strings.Repeat("ab", 20))
+	nodeAccountID := []AccountID{{Account: 3}}
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs(nodeAccountID).
+		SetTransactionID(testTransactionID).
+		SetAliasResolver(NewAliasResolver(client)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-3)).
+		AddHbarTransfer(alias, NewHbar(1)).
+		AddHbarTransfer(AccountID{Account: 10}, NewHbar(1)).
+		AddHbarTransfer(unknown, NewHbar(1)).
+		AddTokenTransfer(TokenID{Token: 5}, alias, 4).
+		AddTokenTransfer(TokenID{Token: 5}, AccountID{Account: 2}, -4).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6}, SerialNumber: 1}, AccountID{Account: 2}, alias).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	hbarTransfers := tx.GetHbarTransfers()
+	require.Len(t, hbarTransfers, 3)
+	assert.Equal(t, NewHbar(2), hbarTransfers[AccountID{Account: 10}])
+	assert.Equal(t, NewHbar(1), hbarTransfers[unknown])
+
+	tokenTransfers := tx.GetTokenTransfers()[TokenID{Token: 5}]
+	require.Len(t, tokenTransfers, 2)
+	tokenAmounts := make(map[AccountID]int64)
+	for _, transfer := range tokenTransfers {
+		tokenAmounts[transfer.AccountID] = transfer.Amount
+	}
+	assert.Equal(t, int64(4), tokenAmounts[AccountID{Account: 10}])
+
+	nftTransfers := tx.GetNftTransfers()[TokenID{Token: 6}]
+	require.Len(t, nftTransfers, 1)
+	assert.Equal(t, AccountID{Account: 10}, nftTransfers[0].ReceiverAccountID)
+}
@@ -0,0 +1,441 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// The network limit on the hbar, fungible token and NFT balance changes a single CryptoTransfer may carry
+// in total (ledger.xferBalanceChanges.maxLen), on top of the limits for each kind
+const maxBalanceChanges = 20
+
+// BatchTransferPayout is a single payout from the treasury of a BatchTransferBuilder.
+type BatchTransferPayout struct {
+	Recipient AccountID
+	// The token being paid out, nil for hbar payouts
+	TokenID *TokenID
+	// Tinybars for hbar payouts, the lowest denomination for fungible token payouts, zero for NFT payouts
+	Amount int64
+	// The serial number for NFT payouts, zero otherwise
+	SerialNumber int64
+}
+
+// BatchTransferResult is the outcome of a single payout of a BatchTransferBuilder.
+type BatchTransferResult struct {
+	Payout BatchTransferPayout
+	// The transaction which carried the payout
+	TransactionID TransactionID
+	// The receipt status of the transaction, the precheck status if it was rejected, or
+	// StatusUnknown if it failed before either was known
+	Status Status
+	// Non nil if the transaction carrying the payout failed
+	Err error
+}
+
+// BatchTransferProgress is reported to the progress callback after every transaction a
+// BatchTransferBuilder executes.
+type BatchTransferProgress struct {
+	TransactionID TransactionID
+	// The number of transactions executed so far, including this one
+	Completed int
+	// The total number of transactions the payouts were packed into
+	Total int
+	// Non nil if this transaction failed
+	Err error
+}
+
+// BatchTransferBuilder pays out arbitrary numbers of hbar, fungible token and NFT payouts from a single
+// treasury account. The payouts are packed into as few TransferTransactions as possible while staying
+// within the network limits on hbar transfers, token transfers, token transfer lists, NFT transfers, balance
+// changes in total and transaction size, so none of the resulting transactions fail with TRANSFER_LIST_SIZE_LIMIT_EXCEEDED or TRANSACTION_OVERSIZE.
+type BatchTransferBuilder struct {
+	treasury          AccountID
+	payouts           []BatchTransferPayout
+	memo              string
+	maxTransactionFee *Hbar
+	nodeAccountIDs    []AccountID
+	signers           []PrivateKey
+	progress          func(BatchTransferProgress)
+}
+
+type _BatchTransferChunk struct {
+	payouts      []int
+	hbarCount    int
+	tokenEntries int
+	nftCount     int
+	tokens       map[TokenID]bool
+}
+
+func (chunk *_BatchTransferChunk) _BalanceChanges() int {
+	return chunk.hbarCount + chunk.tokenEntries + chunk.nftCount
+}
+
+// NewBatchTransferBuilder creates a BatchTransferBuilder paying out from the given treasury account.
+func NewBatchTransferBuilder(treasury AccountID) *BatchTransferBuilder {
+	return &BatchTransferBuilder{
+		treasury: treasury,
+		payouts:  make([]BatchTransferPayout, 0),
+		signers:  make([]PrivateKey, 0),
+	}
+}
+
+// GetTreasury returns the account every payout is debited from
+func (builder *BatchTransferBuilder) GetTreasury() AccountID {
+	return builder.treasury
+}
+
+// AddHbarPayout adds a payout of amount hbar to recipient
+func (builder *BatchTransferBuilder) AddHbarPayout(recipient AccountID, amount Hbar) *BatchTransferBuilder {
+	builder.payouts = append(builder.payouts, BatchTransferPayout{
+		Recipient: recipient,
+		Amount:    amount.AsTinybar(),
+	})
+	return builder
+}
+
+// AddTokenPayout adds a payout of amount units, in the lowest denomination, of a fungible token to recipient
+func (builder *BatchTransferBuilder) AddTokenPayout(tokenID TokenID, recipient AccountID, amount int64) *BatchTransferBuilder {
+	builder.payouts = append(builder.payouts, BatchTransferPayout{
+		Recipient: recipient,
+		TokenID:   &tokenID,
+		Amount:    amount,
+	})
+	return builder
+}
+
+// AddNftPayout adds a transfer of the NFT from the treasury to recipient
+func (builder *BatchTransferBuilder) AddNftPayout(nftID NftID, recipient AccountID) *BatchTransferBuilder {
+	tokenID := nftID.TokenID
+	builder.payouts = append(builder.payouts, BatchTransferPayout{
+		Recipient:    recipient,
+		TokenID:      &tokenID,
+		SerialNumber: nftID.SerialNumber,
+	})
+	return builder
+}
+
+// GetPayouts returns the payouts in the order they were added
+func (builder *BatchTransferBuilder) GetPayouts() []BatchTransferPayout {
+	return builder.payouts
+}
+
+// SetTransactionMemo sets the memo used for every transaction
+func (builder *BatchTransferBuilder) SetTransactionMemo(memo string) *BatchTransferBuilder {
+	builder.memo = memo
+	return builder
+}
+
+// SetMaxTransactionFee sets the max transaction fee used for every transaction
+func (builder *BatchTransferBuilder) SetMaxTransactionFee(fee Hbar) *BatchTransferBuilder {
+	builder.maxTransactionFee = &fee
+	return builder
+}
+
+// SetNodeAccountIDs sets the node AccountIDs used for every transaction
+func (builder *BatchTransferBuilder) SetNodeAccountIDs(nodeAccountIDs []AccountID) *BatchTransferBuilder {
+	builder.nodeAccountIDs = nodeAccountIDs
+	return builder
+}
+
+// Sign adds a key every transaction is signed with before execution, typically the treasury key
+// when the treasury is not the client operator.
+func (builder *BatchTransferBuilder) Sign(privateKey PrivateKey) *BatchTransferBuilder {
+	builder.signers = append(builder.signers, privateKey)
+	return builder
+}
+
+// SetProgressCallback sets a callback invoked after every transaction is executed
+func (builder *BatchTransferBuilder) SetProgressCallback(progress func(BatchTransferProgress)) *BatchTransferBuilder {
+	builder.progress = progress
+	return builder
+}
+
+// Build packs the payouts into TransferTransactions without freezing them, so they can be
+// customised, scheduled or executed individually.
+func (builder *BatchTransferBuilder) Build() ([]*TransferTransaction, error) {
+	transactions, _, err := builder._Build()
+	return transactions, err
+}
+
+// Execute packs the payouts into TransferTransactions, then executes them one after another and waits for
+// their receipts. A failed transaction does not stop the remaining ones from being executed; its failure is
+// reported in the results of every payout it carried. Results are returned in the order payouts were added.
+func (builder *BatchTransferBuilder) Execute(client *Client) ([]BatchTransferResult, error) {
+	if client == nil {
+		return nil, errNoClientProvided
+	}
+
+	transactions, chunks, err := builder._Build()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchTransferResult, len(builder.payouts))
+	for i, tx := range transactions {
+		transactionID, status, err := builder._ExecuteTransaction(client, tx)
+
+		for _, index := range chunks[i].payouts {
+			results[index] = BatchTransferResult{
+				Payout:        builder.payouts[index],
+				TransactionID: transactionID,
+				Status:        status,
+				Err:           err,
+			}
+		}
+
+		if builder.progress != nil {
+			builder.progress(BatchTransferProgress{
+				TransactionID: transactionID,
+				Completed:     i + 1,
+				Total:         len(transactions),
+				Err:           err,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func (builder *BatchTransferBuilder) _ExecuteTransaction(client *Client, tx *TransferTransaction) (TransactionID, Status, error) {
+	_, err := tx.FreezeWith(client)
+	if err != nil {
+		return TransactionID{}, StatusUnknown, err
+	}
+
+	for _, key := range builder.signers {
+		tx.Sign(key)
+	}
+
+	response, err := tx.Execute(client)
+	if err != nil {
+		var precheckErr ErrHederaPreCheckStatus
+		if errors.As(err, &precheckErr) {
+			return tx.GetTransactionID(), precheckErr.Status, err
+		}
+		return tx.GetTransactionID(), StatusUnknown, err
+	}
+
+	receipt, err := response.GetReceipt(client)
+	if err != nil {
+		var receiptErr ErrHederaReceiptStatus
+		if errors.As(err, &receiptErr) {
+			return response.TransactionID, receiptErr.Status, err
+		}
+		return response.TransactionID, StatusUnknown, err
+	}
+
+	return response.TransactionID, receipt.Status, nil
+}
+
+func (builder *BatchTransferBuilder) _Validate() error {
+	findings := make([]ValidationFinding, 0)
+
+	for i, payout := range builder.payouts {
+		if payout.Recipient.Compare(builder.treasury) == 0 {
+			findings = append(findings, ValidationFinding{
+				Status:  StatusAccountRepeatedInAccountAmounts,
+				Message: fmt.Sprintf("payout %d is paid to the treasury", i),
+			})
+		}
+
+		if payout.TokenID != nil && payout.SerialNumber != 0 {
+			if payout.SerialNumber < 0 {
+				findings = append(findings, ValidationFinding{
+					Status:  StatusInvalidTokenNftSerialNumber,
+					Message: fmt.Sprintf("payout %d has a negative serial number", i),
+				})
+			}
+			continue
+		}
+
+		if payout.Amount <= 0 {
+			findings = append(findings, ValidationFinding{
+				Status:  StatusInvalidAccountAmounts,
+				Message: fmt.Sprintf("payout %d has a non-positive amount of %d", i, payout.Amount),
+			})
+		}
+	}
+
+	if len(findings) > 0 {
+		return ErrLocalValidation{Findings: findings}
+	}
+
+	return nil
+}
+
+func (builder *BatchTransferBuilder) _Build() ([]*TransferTransaction, []*_BatchTransferChunk, error) {
+	if err := builder._Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	chunks := builder._Pack()
+	transactions := make([]*TransferTransaction, 0, len(chunks))
+
+	for i := 0; i < len(chunks); i++ {
+		tx := builder._Transaction(chunks[i])
+
+		// every signer adds a signature pair on top of the operator's
+		size, err := tx.EstimateSize(len(builder.signers) + 1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// a subset of payouts never needs more entries than the chunk it was taken from, so halving an
+		// oversized chunk keeps both halves within the entry limits
+		if size > TransactionSizeLimit && len(chunks[i].payouts) > 1 {
+			half := len(chunks[i].payouts) / 2
+			split := []*_BatchTransferChunk{
+				{payouts: append([]int{}, chunks[i].payouts[:half]...)},
+				{payouts: append([]int{}, chunks[i].payouts[half:]...)},
+			}
+			chunks = append(chunks[:i], append(split, chunks[i+1:]...)...)
+			i--
+			continue
+		}
+
+		transactions = append(transactions, tx)
+	}
+
+	return transactions, chunks, nil
+}
+
+func (builder *BatchTransferBuilder) _Transaction(chunk *_BatchTransferChunk) *TransferTransaction {
+	tx := NewTransferTransaction()
+
+	for _, index := range chunk.payouts {
+		payout := builder.payouts[index]
+		switch {
+		case payout.TokenID == nil:
+			tx.AddHbarTransfer(payout.Recipient, HbarFromTinybar(payout.Amount)).
+				AddHbarTransfer(builder.treasury, HbarFromTinybar(-payout.Amount))
+		case payout.SerialNumber != 0:
+			tx.AddNftTransfer(NftID{TokenID: *payout.TokenID, SerialNumber: payout.SerialNumber}, builder.treasury, payout.Recipient)
+		default:
+			tx.AddTokenTransfer(*payout.TokenID, payout.Recipient, payout.Amount).
+				AddTokenTransfer(*payout.TokenID, builder.treasury, -payout.Amount)
+		}
+	}
+
+	if builder.memo != "" {
+		tx.SetTransactionMemo(builder.memo)
+	}
+	if builder.maxTransactionFee != nil {
+		tx.SetMaxTransactionFee(*builder.maxTransactionFee)
+	}
+	if len(builder.nodeAccountIDs) > 0 {
+		tx.SetNodeAccountIDs(builder.nodeAccountIDs)
+	}
+
+	return tx
+}
+
+// _Pack assigns every payout to a chunk. Hbar payouts and fungible token payouts are packed independently
+// of each other since the network limits them separately, then NFT payouts fill the first chunk with room
+// for them. Every chunk pays for one treasury debit per hbar and per fungible token it carries, and carries
+// at most maxBalanceChanges hbar, token and NFT transfers in total.
+func (builder *BatchTransferBuilder) _Pack() []*_BatchTransferChunk {
+	chunks := make([]*_BatchTransferChunk, 0)
+	chunkAt := func(i int) *_BatchTransferChunk {
+		for len(chunks) <= i {
+			chunks = append(chunks, &_BatchTransferChunk{
+				payouts: make([]int, 0),
+				tokens:  make(map[TokenID]bool),
+			})
+		}
+		return chunks[i]
+	}
+
+	hbarChunk := 0
+	tokenChunk := 0
+	tokenOrder := make([]TokenID, 0)
+	tokenPayouts := make(map[TokenID][]int)
+	nftPayouts := make([]int, 0)
+
+	for i, payout := range builder.payouts {
+		switch {
+		case payout.TokenID == nil:
+			chunk := chunkAt(hbarChunk)
+			if chunk.hbarCount == 0 {
+				// the treasury debit
+				chunk.hbarCount++
+			}
+			chunk.hbarCount++
+			chunk.payouts = append(chunk.payouts, i)
+			if chunk.hbarCount == maxHbarTransfers {
+				hbarChunk++
+			}
+		case payout.SerialNumber != 0:
+			nftPayouts = append(nftPayouts, i)
+		default:
+			if _, ok := tokenPayouts[*payout.TokenID]; !ok {
+				tokenOrder = append(tokenOrder, *payout.TokenID)
+			}
+			tokenPayouts[*payout.TokenID] = append(tokenPayouts[*payout.TokenID], i)
+		}
+	}
+
+	for _, tokenID := range tokenOrder {
+		for _, index := range tokenPayouts[tokenID] {
+			chunk := chunkAt(tokenChunk)
+			needed := 1
+			if !chunk.tokens[tokenID] {
+				// the treasury debit
+				needed++
+			}
+			if chunk.tokenEntries+needed > maxTokenTransfers || chunk._BalanceChanges()+needed > maxBalanceChanges {
+				tokenChunk++
+				chunk = chunkAt(tokenChunk)
+				needed = 2
+			}
+
+			chunk.tokens[tokenID] = true
+			chunk.tokenEntries += needed
+			chunk.payouts = append(chunk.payouts, index)
+		}
+	}
+
+	first := 0
+	for _, index := range nftPayouts {
+		tokenID := *builder.payouts[index].TokenID
+		for i := first; ; i++ {
+			chunk := chunkAt(i)
+			if chunk.nftCount == maxNftTransfers || chunk._BalanceChanges() == maxBalanceChanges {
+				if i == first {
+					first++
+				}
+				continue
+			}
+			if !chunk.tokens[tokenID] && len(chunk.tokens) == maxTokenTransferLists {
+				continue
+			}
+
+			chunk.tokens[tokenID] = true
+			chunk.nftCount++
+			chunk.payouts = append(chunk.payouts, index)
+			break
+		}
+	}
+
+	return chunks
+}
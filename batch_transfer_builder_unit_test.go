@@ -0,0 +1,200 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitBatchTransferBuilderPacksHbar(t *testing.T) {
+	t.Parallel()
+
+	treasury := AccountID{Account: 2}
+	builder := NewBatchTransferBuilder(treasury)
+	for i := 0; i < 20; i++ {
+		builder.AddHbarPayout(AccountID{Account: uint64(1000 + i)}, HbarFromTinybar(5))
+	}
+
+	transactions, err := builder.Build()
+	require.NoError(t, err)
+	require.Len(t, transactions, 3)
+
+	for _, tx := range transactions {
+		require.NoError(t, tx.Validate())
+		require.LessOrEqual(t, len(tx.GetHbarTransfers()), maxHbarTransfers)
+	}
+	require.Equal(t, HbarFromTinybar(-45), transactions[0].GetHbarTransfers()[treasury])
+	require.Equal(t, HbarFromTinybar(-10), transactions[2].GetHbarTransfers()[treasury])
+}
+
+func TestUnitBatchTransferBuilderPacksMixedPayouts(t *testing.T) {
+	t.Parallel()
+
+	treasury := AccountID{Account: 2}
+	builder := NewBatchTransferBuilder(treasury)
+	for i := 0; i < 9; i++ {
+		builder.AddHbarPayout(AccountID{Account: uint64(1000 + i)}, HbarFromTinybar(1))
+	}
+	for i := 0; i < 12; i++ {
+		builder.AddTokenPayout(TokenID{Token: uint64(50 + i%3)}, AccountID{Account: uint64(2000 + i)}, 7)
+	}
+	for i := 0; i < 25; i++ {
+		builder.AddNftPayout(NftID{TokenID: TokenID{Token: uint64(60 + i)}, SerialNumber: 1}, AccountID{Account: uint64(3000 + i)})
+	}
+
+	transactions, err := builder.Build()
+	require.NoError(t, err)
+	require.Len(t, transactions, 4)
+
+	payouts := 0
+	for _, tx := range transactions {
+		require.NoError(t, tx.Validate())
+		balanceChanges := len(tx.GetHbarTransfers())
+		for _, transfers := range tx.GetTokenTransfers() {
+			payouts += len(transfers) - 1
+			balanceChanges += len(transfers)
+		}
+		for _, transfers := range tx.GetNftTransfers() {
+			payouts += len(transfers)
+			balanceChanges += len(transfers)
+		}
+		if len(tx.GetHbarTransfers()) > 0 {
+			payouts += len(tx.GetHbarTransfers()) - 1
+		}
+		require.LessOrEqual(t, balanceChanges, maxBalanceChanges)
+	}
+	require.Equal(t, 46, payouts)
+}
+
+func TestUnitBatchTransferBuilderSplitsOversizedTransactions(t *testing.T) {
+	t.Parallel()
+
+	treasury := AccountID{Account: 2}
+	builder := NewBatchTransferBuilder(treasury)
+	for i := 0; i < 9; i++ {
+		builder.AddHbarPayout(AccountID{Account: uint64(1000 + i)}, HbarFromTinybar(1))
+	}
+
+	transactions, err := builder.Build()
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+
+	// add signers until a single transaction carrying every payout no longer fits
+	for len(transactions) == 1 {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		builder.Sign(key)
+
+		transactions, err = builder.Build()
+		require.NoError(t, err)
+		require.Less(t, len(builder.signers), 100)
+	}
+
+	payouts := 0
+	for _, tx := range transactions {
+		size, err := tx.EstimateSize(len(builder.signers) + 1)
+		require.NoError(t, err)
+		require.LessOrEqual(t, size, TransactionSizeLimit)
+		payouts += len(tx.GetHbarTransfers()) - 1
+	}
+	require.Equal(t, 9, payouts)
+}
+
+func TestUnitBatchTransferBuilderInvalidPayouts(t *testing.T) {
+	t.Parallel()
+
+	treasury := AccountID{Account: 2}
+	_, err := NewBatchTransferBuilder(treasury).
+		AddHbarPayout(AccountID{Account: 3}, HbarFromTinybar(-1)).
+		AddTokenPayout(TokenID{Token: 5}, treasury, 1).
+		Build()
+
+	var validationErr ErrLocalValidation
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Findings, 2)
+	require.Equal(t, StatusInvalidAccountAmounts, validationErr.Findings[0].Status)
+	require.Equal(t, StatusAccountRepeatedInAccountAmounts, validationErr.Findings[1].Status)
+}
+
+func TestUnitBatchTransferBuilderMock(t *testing.T) {
+	t.Parallel()
+
+	receipt := func(status services.ResponseCodeEnum) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						Cost:         0,
+						ResponseType: services.ResponseType_ANSWER_ONLY,
+					},
+					Receipt: &services.TransactionReceipt{
+						Status: status,
+					},
+				},
+			},
+		}
+	}
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		receipt(services.ResponseCodeEnum_SUCCESS),
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		receipt(services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	builder := NewBatchTransferBuilder(client.GetOperatorAccountID()).
+		SetNodeAccountIDs([]AccountID{{Account: 3}})
+	for i := 0; i < 10; i++ {
+		builder.AddHbarPayout(AccountID{Account: uint64(1000 + i)}, HbarFromTinybar(1))
+	}
+
+	progress := make([]BatchTransferProgress, 0)
+	results, err := builder.
+		SetProgressCallback(func(p BatchTransferProgress) {
+			progress = append(progress, p)
+		}).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, results, 10)
+	require.Len(t, progress, 2)
+	require.Equal(t, 2, progress[1].Total)
+
+	for _, result := range results[:9] {
+		require.NoError(t, result.Err)
+		require.Equal(t, StatusSuccess, result.Status)
+	}
+	require.Error(t, results[9].Err)
+	require.Equal(t, StatusTokenNotAssociatedToAccount, results[9].Status)
+	require.Equal(t, AccountID{Account: 1009}, results[9].Payout.Recipient)
+}
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -54,6 +55,7 @@ type Client struct {
 	network                         _Network
 	mirrorNetwork                   *_MirrorNetwork
 	autoValidateChecksums           bool
+	autoValidateTransactions        bool
 	defaultRegenerateTransactionIDs bool
 	maxAttempts                     *int
 
@@ -65,6 +67,14 @@ type Client struct {
 	networkUpdateContext       context.Context
 	cancelNetworkUpdate        context.CancelFunc
 	logger                     Logger
+
+	transactionSizeWarningThreshold int
+	transactionSizeWarningHook      func(TransactionSizeWarning)
+
+	mirrorRestURL      string
+	mirrorPollTimeout  time.Duration
+	mirrorPollInterval time.Duration
+	mirrorPollSet      bool
 }
 
 // TransactionSigner is a closure or function that defines how transactions will be signed
@@ -220,6 +230,7 @@ type _ConfigOperator struct {
 type _ClientConfig struct {
 	Network       interface{}      `json:"network"`
 	MirrorNetwork interface{}      `json:"mirrorNetwork"`
+	MirrorRestURL string           `json:"mirrorRestUrl"`
 	Operator      *_ConfigOperator `json:"operator"`
 }
 
@@ -299,6 +310,10 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 		return client, errors.New("mirrorNetwork is expected to be a string, an array of strings or nil")
 	}
 
+	if client != nil && clientConfig.MirrorRestURL != "" {
+		client.SetMirrorRestURL(clientConfig.MirrorRestURL)
+	}
+
 	// if the _Operator is not provided, finish here
 	if clientConfig.Operator == nil {
 		return client, nil
@@ -497,6 +512,45 @@ func (client *Client) GetMirrorNetwork() []string {
 	return client.mirrorNetwork._GetNetwork()
 }
 
+// SetMirrorRestURL sets the URL of the mirror node REST API, e.g. "https://mirror.example.com:8443".
+// By default it is derived from the first mirror network address, which only works when the mirror
+// node serves REST and gRPC on the same host.
+func (client *Client) SetMirrorRestURL(restURL string) *Client {
+	restURL = strings.TrimSuffix(restURL, "/")
+	if restURL != "" && !strings.HasSuffix(restURL, API_VERSION) {
+		restURL += API_VERSION
+	}
+	client.mirrorRestURL = restURL
+
+	return client
+}
+
+// GetMirrorRestURL returns the URL of the mirror node REST API, including the API version
+func (client *Client) GetMirrorRestURL() string {
+	return fetchMirrorNodeUrlFromClient(client)
+}
+
+// SetMirrorPolling sets how long requests made to the mirror node REST API wait for it to ingest
+// the entity or consensus timestamp they need, and how often it is polled meanwhile.
+// A zero timeout disables polling. When unset, polling is only enabled for local networks.
+func (client *Client) SetMirrorPolling(timeout time.Duration, interval time.Duration) *Client {
+	client.mirrorPollTimeout = timeout
+	client.mirrorPollInterval = interval
+	client.mirrorPollSet = true
+
+	return client
+}
+
+// GetMirrorPollingTimeout returns how long requests wait for the mirror node to catch up
+func (client *Client) GetMirrorPollingTimeout() time.Duration {
+	return client.mirrorPollTimeout
+}
+
+// GetMirrorPollingInterval returns the interval between polls of the mirror node
+func (client *Client) GetMirrorPollingInterval() time.Duration {
+	return client.mirrorPollInterval
+}
+
 // SetTransportSecurity sets if transport security should be used to connect to consensus nodes.
 // If transport security is enabled all connections to consensus nodes will use TLS, and
 // the server's certificate hash will be compared to the hash stored in the NodeAddressBook
@@ -553,6 +607,31 @@ func (client *Client) GetAutoValidateChecksums() bool {
 	return client.autoValidateChecksums
 }
 
+// SetAutoValidateTransactions sets if transactions should run their local sanity checks (see Validate)
+// when they are frozen with this client.
+func (client *Client) SetAutoValidateTransactions(validate bool) {
+	client.autoValidateTransactions = validate
+}
+
+// GetAutoValidateTransactions returns if transactions run their local sanity checks when frozen with this client.
+func (client *Client) GetAutoValidateTransactions() bool {
+	return client.autoValidateTransactions
+}
+
+// SetTransactionSizeWarning logs a warning, and calls hook if it isn't nil, whenever a transaction about to be
+// executed is at least threshold bytes large. TransactionSizeLimit is the network limit. A threshold of zero
+// disables the warning, which is the default.
+func (client *Client) SetTransactionSizeWarning(threshold int, hook func(TransactionSizeWarning)) *Client {
+	client.transactionSizeWarningThreshold = threshold
+	client.transactionSizeWarningHook = hook
+	return client
+}
+
+// GetTransactionSizeWarningThreshold returns the size in bytes at which transactions trigger the size warning
+func (client *Client) GetTransactionSizeWarningThreshold() int {
+	return client.transactionSizeWarningThreshold
+}
+
 // SetDefaultRegenerateTransactionIDs sets if an automatic transaction ID regeneration should be performed.
 func (client *Client) SetDefaultRegenerateTransactionIDs(regen bool) {
 	client.defaultRegenerateTransactionIDs = regen
@@ -328,3 +328,33 @@ func TestUnitClientLogger(t *testing.T) {
 	hl := client.GetLogger()
 	assert.Equal(t, hl, hederaLoger)
 }
+
+func TestUnitClientMirrorRestURL(t *testing.T) {
+	t.Parallel()
+
+	client, err := ClientFromConfig([]byte(`{
+		"network": {"127.0.0.1:50211": "0.0.3"},
+		"mirrorRestUrl": "http://mirror-rest.internal:8080/"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "http://mirror-rest.internal:8080/api/v1", client.GetMirrorRestURL())
+	assert.Equal(t, time.Duration(0), MirrorClientFromClient(client).GetPollingTimeout())
+
+	client.SetMirrorRestURL("https://mirror.example.com/api/v1").
+		SetMirrorPolling(5*time.Second, 100*time.Millisecond)
+	mirror := MirrorClientFromClient(client)
+	assert.Equal(t, "https://mirror.example.com/api/v1", mirror.GetBaseURL())
+	assert.Equal(t, 5*time.Second, mirror.GetPollingTimeout())
+	assert.Equal(t, 100*time.Millisecond, mirror.GetPollingInterval())
+}
+
+func TestUnitClientMirrorPollingDisabledOnLocalNetwork(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	client.SetMirrorNetwork([]string{"127.0.0.1:5600"})
+	assert.Equal(t, localMirrorPollTimeout, MirrorClientFromClient(client).GetPollingTimeout())
+
+	client.SetMirrorPolling(0, 0)
+	assert.Equal(t, time.Duration(0), MirrorClientFromClient(client).GetPollingTimeout())
+}
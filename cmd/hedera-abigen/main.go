@@ -0,0 +1,83 @@
+// Command hedera-abigen generates typed Go bindings for a contract from its ABI and, optionally, its bytecode.
+//
+//	hedera-abigen -abi Token.abi -bin Token.bin -pkg token -type Token -out token.go
+//
+// The ABI file may also be a solc or Hardhat artifact with "abi" and "bytecode" fields.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path of the ABI JSON or of a solc or Hardhat artifact")
+	binPath := flag.String("bin", "", "optional path of the hex encoded bytecode")
+	pkg := flag.String("pkg", "", "package name of the generated file")
+	typeName := flag.String("type", "", "name of the binding type")
+	out := flag.String("out", "", "output file, standard output when empty")
+	flag.Parse()
+
+	if *abiPath == "" || *pkg == "" || *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*abiPath, *binPath, *pkg, *typeName, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "hedera-abigen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath string, binPath string, pkg string, typeName string, out string) error {
+	abiJSON, err := os.ReadFile(abiPath)
+	if err != nil {
+		return err
+	}
+
+	var bytecode string
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode interface{}     `json:"bytecode"`
+	}
+	if json.Unmarshal(abiJSON, &artifact) == nil && len(artifact.ABI) > 0 {
+		abiJSON = artifact.ABI
+		switch value := artifact.Bytecode.(type) {
+		case string:
+			bytecode = value
+		case map[string]interface{}:
+			// solc standard JSON output nests the bytecode under "object"
+			bytecode, _ = value["object"].(string)
+		}
+	}
+
+	if binPath != "" {
+		bin, err := os.ReadFile(binPath)
+		if err != nil {
+			return err
+		}
+		bytecode = string(bin)
+	}
+
+	source, err := hedera.GenerateContractBindings(hedera.ContractBindingOptions{
+		Package:  pkg,
+		Type:     typeName,
+		ABI:      abiJSON,
+		Bytecode: strings.TrimSpace(bytecode),
+	})
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return os.WriteFile(out, source, 0644)
+}
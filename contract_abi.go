@@ -0,0 +1,605 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ContractABI encodes the parameters of and decodes the results of the functions of a contract described by the
+// ABI JSON solc emits. Besides the Go types of go-ethereum, Pack accepts AccountID, ContractID and TokenID for
+// addresses, any integer type, *big.Int or Hbar for integers of any size, byte slices or hex strings for bytes and
+// bytesN, and structs, maps or slices for tuples. UnpackInto likewise fills AccountID, ContractID and TokenID fields
+// from addresses and fixed size integer fields from integers that fit them.
+type ContractABI struct {
+	abi abi.ABI
+}
+
+// ContractABIFromJSON parses the ABI JSON of a contract
+func ContractABIFromJSON(data []byte) (*ContractABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(string(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractABI{abi: parsed}, nil
+}
+
+// GetABI returns the go-ethereum representation of the ABI
+func (contractABI *ContractABI) GetABI() abi.ABI {
+	return contractABI.abi
+}
+
+// Pack encodes the call of the function with the arguments, prefixed with the function selector, ready for
+// ContractExecuteTransaction.SetFunctionParameters or ContractCallQuery.SetFunctionParameters. Overloaded functions
+// are named by their signature, such as "transfer(address,uint256)".
+func (contractABI *ContractABI) Pack(name string, args ...interface{}) ([]byte, error) {
+	method, err := contractABI._Method(name)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := _ABIConvertArguments(method.Inputs, args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "function %s", method.Sig)
+	}
+
+	data, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, method.ID...), data...), nil
+}
+
+// PackConstructor encodes the arguments of the constructor, ready for ContractCreateTransaction.SetConstructorParametersRaw
+func (contractABI *ContractABI) PackConstructor(args ...interface{}) ([]byte, error) {
+	values, err := _ABIConvertArguments(contractABI.abi.Constructor.Inputs, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "constructor")
+	}
+
+	return contractABI.abi.Constructor.Inputs.Pack(values...)
+}
+
+// Unpack decodes the output of the function, such as ContractFunctionResult.ContractCallResult, into Go values of the
+// types of go-ethereum: *big.Int for integers above 64 bits, common.Address for addresses, [N]byte for bytesN and
+// structs for tuples.
+func (contractABI *ContractABI) Unpack(name string, output []byte) ([]interface{}, error) {
+	method, err := contractABI._Method(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Unpack(output)
+}
+
+// UnpackInto decodes the output of the function into v, which points to a struct with a field for every output, or
+// to a value of the type of the only output
+func (contractABI *ContractABI) UnpackInto(v interface{}, name string, output []byte) error {
+	method, err := contractABI._Method(name)
+	if err != nil {
+		return err
+	}
+
+	values, err := method.Outputs.Unpack(output)
+	if err != nil {
+		return err
+	}
+
+	destination := reflect.ValueOf(v)
+	if destination.Kind() != reflect.Ptr || destination.IsNil() {
+		return fmt.Errorf("cannot unpack into %T", v)
+	}
+	destination = destination.Elem()
+
+	// a single tuple fills the struct and a single address the ID, several outputs fill the fields by name
+	if len(method.Outputs) == 1 && (method.Outputs[0].Type.T == abi.TupleTy ||
+		(method.Outputs[0].Type.T == abi.AddressTy && _ABIIsEntityID(destination.Type())) ||
+		destination.Kind() != reflect.Struct) {
+		return _ABIAssign(destination, reflect.ValueOf(values[0]))
+	}
+	if destination.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot unpack %d outputs into %T", len(method.Outputs), v)
+	}
+
+	for i, output := range method.Outputs {
+		field := _ABIStructField(destination, output.Name, abi.ToCamelCase(output.Name))
+		if !field.IsValid() && destination.NumField() == len(method.Outputs) {
+			field = destination.Field(i)
+		}
+		if !field.IsValid() {
+			return fmt.Errorf("no field for output %s", output.Name)
+		}
+		if err := _ABIAssign(field, reflect.ValueOf(values[i])); err != nil {
+			return errors.Wrapf(err, "output %s", output.Name)
+		}
+	}
+
+	return nil
+}
+
+// UnpackResult decodes the output of the function from the result of a call
+func (contractABI *ContractABI) UnpackResult(name string, result ContractFunctionResult) ([]interface{}, error) {
+	return contractABI.Unpack(name, result.ContractCallResult)
+}
+
+// UnpackLog decodes the parameters of the event from the log, in the order the event declares them. Indexed
+// parameters of dynamic types, such as strings, bytes, arrays and tuples, are only recorded as the Keccak-256 hash of
+// their value and decode to a common.Hash.
+func (contractABI *ContractABI) UnpackLog(name string, log ContractLogInfo) ([]interface{}, error) {
+	event, err := contractABI._Event(name)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := log.Topics
+	if !event.Anonymous {
+		if len(topics) == 0 || !bytes.Equal(topics[0], event.ID.Bytes()) {
+			return nil, fmt.Errorf("log is not a %s event", event.Sig)
+		}
+		topics = topics[1:]
+	}
+
+	nonIndexed, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "event %s", event.Sig)
+	}
+
+	values := make([]interface{}, 0, len(event.Inputs))
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			values = append(values, nonIndexed[0])
+			nonIndexed = nonIndexed[1:]
+			continue
+		}
+
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("event %s: missing topic for %s", event.Sig, input.Name)
+		}
+
+		value, err := _ABITopic(input.Type, topics[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "event %s", event.Sig)
+		}
+		values = append(values, value)
+		topics = topics[1:]
+	}
+
+	return values, nil
+}
+
+func (contractABI *ContractABI) _Method(name string) (abi.Method, error) {
+	if method, ok := contractABI.abi.Methods[name]; ok {
+		return method, nil
+	}
+
+	if strings.Contains(name, "(") {
+		for _, method := range contractABI.abi.Methods {
+			if method.Sig == name {
+				return method, nil
+			}
+		}
+	}
+
+	return abi.Method{}, fmt.Errorf("no function %s in ABI", name)
+}
+
+func (contractABI *ContractABI) _Event(name string) (abi.Event, error) {
+	if event, ok := contractABI.abi.Events[name]; ok {
+		return event, nil
+	}
+
+	if strings.Contains(name, "(") {
+		for _, event := range contractABI.abi.Events {
+			if event.Sig == name {
+				return event, nil
+			}
+		}
+	}
+
+	return abi.Event{}, fmt.Errorf("no event %s in ABI", name)
+}
+
+// _ABITopic decodes an indexed event parameter from its topic
+func _ABITopic(t abi.Type, topic []byte) (interface{}, error) {
+	if len(topic) != common.HashLength {
+		return nil, fmt.Errorf("topic of %d bytes", len(topic))
+	}
+
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return common.BytesToHash(topic), nil
+	}
+
+	values, err := abi.Arguments{{Type: t}}.Unpack(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0], nil
+}
+
+// _ABIAssign assigns a decoded value to dst, matching the fields of structs by their name or `abi` tag
+func _ABIAssign(dst reflect.Value, src reflect.Value) error {
+	if address, ok := src.Interface().(common.Address); ok && _ABIIsAddressTarget(dst.Type()) {
+		return _ABIAssignAddress(dst, address)
+	}
+	if integer, ok := src.Interface().(*big.Int); ok && dst.Type() != src.Type() {
+		switch dst.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if !integer.IsInt64() || dst.OverflowInt(integer.Int64()) {
+				return fmt.Errorf("value %s overflows %s", integer.String(), dst.Type().String())
+			}
+			dst.SetInt(integer.Int64())
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if !integer.IsUint64() || dst.OverflowUint(integer.Uint64()) {
+				return fmt.Errorf("value %s overflows %s", integer.String(), dst.Type().String())
+			}
+			dst.SetUint(integer.Uint64())
+			return nil
+		}
+	}
+
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+		return nil
+	case dst.Kind() == reflect.Struct && src.Kind() == reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			field := src.Type().Field(i)
+			target := _ABIStructField(dst, field.Tag.Get("json"), field.Name)
+			if !target.IsValid() {
+				continue
+			}
+			if err := _ABIAssign(target, src.Field(i)); err != nil {
+				return errors.Wrapf(err, "field %s", field.Name)
+			}
+		}
+		return nil
+	case dst.Kind() == reflect.Slice && (src.Kind() == reflect.Slice || src.Kind() == reflect.Array):
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		fallthrough
+	case dst.Kind() == reflect.Array && (src.Kind() == reflect.Slice || src.Kind() == reflect.Array) && dst.Len() == src.Len():
+		for i := 0; i < src.Len(); i++ {
+			if err := _ABIAssign(dst.Index(i), src.Index(i)); err != nil {
+				return errors.Wrapf(err, "element %d", i)
+			}
+		}
+		return nil
+	case src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("cannot assign %s to %s", src.Type().String(), dst.Type().String())
+}
+
+// _ABIIsEntityID reports whether t is one of the IDs an address can be assigned to as a whole
+func _ABIIsEntityID(t reflect.Type) bool {
+	return t == reflect.TypeOf(AccountID{}) || t == reflect.TypeOf(ContractID{}) || t == reflect.TypeOf(TokenID{})
+}
+
+// _ABIIsAddressTarget reports whether an address is assigned to t by _ABIAssignAddress rather than converted
+func _ABIIsAddressTarget(t reflect.Type) bool {
+	return _ABIIsEntityID(t) || t == reflect.TypeOf("") || t == reflect.TypeOf([]byte(nil))
+}
+
+// _ABIAssignAddress assigns the address to an AccountID, ContractID, TokenID, hex string or byte slice. Long zero
+// addresses become the entity number, any other address the EVM address of an account or contract. Tokens only
+// have long zero addresses.
+func _ABIAssignAddress(dst reflect.Value, address common.Address) error {
+	longZero := bytes.Equal(address[:12], make([]byte, 12))
+	shard, realm, num, err := _IdFromSolidityAddress(hex.EncodeToString(address.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	switch dst.Interface().(type) {
+	case AccountID:
+		if longZero {
+			value = AccountID{Shard: shard, Realm: realm, Account: num}
+		} else {
+			evmAddress := address.Bytes()
+			value = AccountID{AliasEvmAddress: &evmAddress}
+		}
+	case ContractID:
+		if longZero {
+			value = ContractID{Shard: shard, Realm: realm, Contract: num}
+		} else {
+			value = ContractID{EvmAddress: address.Bytes()}
+		}
+	case TokenID:
+		if !longZero {
+			return fmt.Errorf("address %s is not the long zero address of a token", address.Hex())
+		}
+		value = TokenID{Shard: shard, Realm: realm, Token: num}
+	case string:
+		value = hex.EncodeToString(address.Bytes())
+	case []byte:
+		value = address.Bytes()
+	default:
+		return fmt.Errorf("cannot assign address to %s", dst.Type().String())
+	}
+	dst.Set(reflect.ValueOf(value))
+
+	return nil
+}
+
+func _ABIConvertArguments(arguments abi.Arguments, args []interface{}) ([]interface{}, error) {
+	if len(args) != len(arguments) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(arguments), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, argument := range arguments {
+		value, err := _ABIConvert(argument.Type, args[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "argument %d (%s)", i, argument.Name)
+		}
+		values[i] = value.Interface()
+	}
+
+	return values, nil
+}
+
+// _ABIConvert converts v to the Go type go-ethereum encodes the ABI type from
+func _ABIConvert(t abi.Type, v interface{}) (reflect.Value, error) {
+	target := t.GetType()
+	value := reflect.ValueOf(v)
+
+	if !value.IsValid() {
+		return reflect.Value{}, fmt.Errorf("nil value for %s", t.String())
+	}
+	if value.Type() == target {
+		return value, nil
+	}
+
+	switch t.T {
+	case abi.AddressTy:
+		address, err := _ABIAddress(v)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(address), nil
+
+	case abi.IntTy, abi.UintTy:
+		integer, err := _ABIBigInt(v)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return _ABIIntValue(t, integer)
+
+	case abi.FixedBytesTy, abi.HashTy:
+		data, ok := _ABIBytes(value)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("cannot use %T as %s", v, t.String())
+		}
+		if len(data) > target.Len() {
+			return reflect.Value{}, fmt.Errorf("%d bytes don't fit %s", len(data), t.String())
+		}
+		result := reflect.New(target).Elem()
+		reflect.Copy(result, reflect.ValueOf(data))
+		return result, nil
+
+	case abi.BytesTy:
+		data, ok := _ABIBytes(value)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("cannot use %T as %s", v, t.String())
+		}
+		return reflect.ValueOf(data), nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			return reflect.Value{}, fmt.Errorf("cannot use %T as %s", v, t.String())
+		}
+		if t.T == abi.ArrayTy && value.Len() != t.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d elements for %s, got %d", t.Size, t.String(), value.Len())
+		}
+
+		result := reflect.New(target).Elem()
+		if t.T == abi.SliceTy {
+			result = reflect.MakeSlice(target, value.Len(), value.Len())
+		}
+		for i := 0; i < value.Len(); i++ {
+			element, err := _ABIConvert(*t.Elem, value.Index(i).Interface())
+			if err != nil {
+				return reflect.Value{}, errors.Wrapf(err, "element %d", i)
+			}
+			result.Index(i).Set(element)
+		}
+		return result, nil
+
+	case abi.TupleTy:
+		return _ABITuple(t, value)
+	}
+
+	if value.Type().ConvertibleTo(target) {
+		return value.Convert(target), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot use %T as %s", v, t.String())
+}
+
+func _ABITuple(t abi.Type, value reflect.Value) (reflect.Value, error) {
+	result := reflect.New(t.TupleType).Elem()
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	for i, elem := range t.TupleElems {
+		rawName := t.TupleRawNames[i]
+
+		var field reflect.Value
+		switch value.Kind() {
+		case reflect.Struct:
+			field = _ABIStructField(value, rawName, t.TupleType.Field(i).Name)
+			if !field.IsValid() && value.NumField() == len(t.TupleElems) {
+				field = value.Field(i)
+			}
+		case reflect.Map:
+			field = value.MapIndex(reflect.ValueOf(rawName))
+		case reflect.Slice, reflect.Array:
+			if value.Len() == len(t.TupleElems) {
+				field = value.Index(i)
+			}
+		}
+		if !field.IsValid() {
+			return reflect.Value{}, fmt.Errorf("missing tuple component %s", rawName)
+		}
+
+		converted, err := _ABIConvert(*elem, field.Interface())
+		if err != nil {
+			return reflect.Value{}, errors.Wrapf(err, "tuple component %s", rawName)
+		}
+		result.Field(i).Set(converted)
+	}
+
+	return result, nil
+}
+
+// _ABIStructField returns the field of the struct tagged `abi:"name"`, or named like the Go field of the tuple type
+func _ABIStructField(value reflect.Value, rawName string, fieldName string) reflect.Value {
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Tag.Get("abi") == rawName {
+			return value.Field(i)
+		}
+	}
+
+	return value.FieldByName(fieldName)
+}
+
+func _ABIAddress(v interface{}) (common.Address, error) {
+	switch address := v.(type) {
+	case AccountID:
+		if address.AliasEvmAddress != nil {
+			return common.BytesToAddress(*address.AliasEvmAddress), nil
+		}
+		return common.HexToAddress(address.ToSolidityAddress()), nil
+	case *AccountID:
+		return _ABIAddress(*address)
+	case ContractID:
+		if address.EvmAddress != nil {
+			return common.BytesToAddress(address.EvmAddress), nil
+		}
+		return common.HexToAddress(address.ToSolidityAddress()), nil
+	case *ContractID:
+		return _ABIAddress(*address)
+	case TokenID:
+		return common.HexToAddress(address.ToSolidityAddress()), nil
+	case *TokenID:
+		return _ABIAddress(*address)
+	case []byte:
+		if len(address) != common.AddressLength {
+			return common.Address{}, fmt.Errorf("address must be %d bytes, got %d", common.AddressLength, len(address))
+		}
+		return common.BytesToAddress(address), nil
+	case string:
+		if !common.IsHexAddress(address) {
+			return common.Address{}, fmt.Errorf("invalid address %s", address)
+		}
+		return common.HexToAddress(address), nil
+	}
+
+	return common.Address{}, fmt.Errorf("cannot use %T as address", v)
+}
+
+func _ABIBigInt(v interface{}) (*big.Int, error) {
+	switch integer := v.(type) {
+	case *big.Int:
+		return integer, nil
+	case big.Int:
+		return &integer, nil
+	case Hbar:
+		return big.NewInt(integer.AsTinybar()), nil
+	case string:
+		result, ok := new(big.Int).SetString(integer, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", integer)
+		}
+		return result, nil
+	}
+
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(value.Uint()), nil
+	}
+
+	return nil, fmt.Errorf("cannot use %T as integer", v)
+}
+
+// _ABIIntValue converts the integer to the Go type of the ABI integer type, checking that it fits
+func _ABIIntValue(t abi.Type, integer *big.Int) (reflect.Value, error) {
+	if t.T == abi.UintTy && integer.Sign() < 0 {
+		return reflect.Value{}, fmt.Errorf("negative value %s for %s", integer.String(), t.String())
+	}
+
+	bits := integer.BitLen()
+	if t.T == abi.IntTy && integer.Sign() < 0 {
+		bits = new(big.Int).Add(integer, big.NewInt(1)).BitLen()
+	}
+	if (t.T == abi.UintTy && bits > t.Size) || (t.T == abi.IntTy && bits >= t.Size) {
+		return reflect.Value{}, fmt.Errorf("value %s overflows %s", integer.String(), t.String())
+	}
+
+	target := t.GetType()
+	switch target.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(integer.Int64()).Convert(target), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(integer.Uint64()).Convert(target), nil
+	}
+
+	return reflect.ValueOf(new(big.Int).Set(integer)), nil
+}
+
+func _ABIBytes(value reflect.Value) ([]byte, bool) {
+	if text, ok := value.Interface().(string); ok {
+		data, err := hex.DecodeString(strings.TrimPrefix(text, "0x"))
+		return data, err == nil
+	}
+
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+		return value.Bytes(), true
+	}
+
+	if value.Kind() == reflect.Array && value.Type().Elem().Kind() == reflect.Uint8 {
+		data := make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(data), value)
+		return data, true
+	}
+
+	return nil, false
+}
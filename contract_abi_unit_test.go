@@ -0,0 +1,276 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractABI = `[
+	{"type":"constructor","inputs":[{"name":"owner","type":"address"}]},
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"set","inputs":[{"name":"value","type":"uint8"}],"outputs":[]},
+	{"type":"function","name":"set","inputs":[{"name":"value","type":"string"}],"outputs":[]},
+	{"type":"function","name":"submit","inputs":[{"name":"orders","type":"tuple[]","components":[{"name":"maker","type":"address"},{"name":"amounts","type":"uint64[2]"},{"name":"salt","type":"bytes32"}]}],"outputs":[]},
+	{"type":"function","name":"matrix","inputs":[],"outputs":[{"name":"rows","type":"int32[][]"},{"name":"tag","type":"bytes4"}],"stateMutability":"view"},
+	{"type":"function","name":"order","inputs":[],"outputs":[{"name":"order","type":"tuple","components":[{"name":"maker","type":"address"},{"name":"amount","type":"uint256"}]}],"stateMutability":"view"},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"memo","type":"string","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Paused","inputs":[]}
+]`
+
+func _TestContractABI(t *testing.T) *ContractABI {
+	contractABI, err := ContractABIFromJSON([]byte(testContractABI))
+	require.NoError(t, err)
+	return contractABI
+}
+
+func TestUnitContractABIPackMatchesContractFunctionParameters(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+
+	data, err := contractABI.Pack("transfer", AccountID{Account: 1234}, 5000)
+	require.NoError(t, err)
+
+	params, err := NewContractFunctionParameters().
+		AddAddress(AccountID{Account: 1234}.ToSolidityAddress())
+	require.NoError(t, err)
+	expected := params.AddUint256(common.LeftPadBytes(big.NewInt(5000).Bytes(), 32))._Build(&[]string{"transfer"}[0])
+
+	assert.Equal(t, hex.EncodeToString(expected), hex.EncodeToString(data))
+}
+
+func TestUnitContractABIPackOverloadBySignature(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+
+	data, err := contractABI.Pack("set(string)", "hello")
+	require.NoError(t, err)
+	selector := NewContractFunctionSelector("set")
+	assert.Equal(t, selector.AddString()._Build(nil), data[:4])
+
+	_, err = contractABI.Pack("set(uint8)", 256)
+	require.ErrorContains(t, err, "overflows uint8")
+
+	_, err = contractABI.Pack("missing")
+	require.Error(t, err)
+}
+
+func TestUnitContractABIPackTuples(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+
+	type order struct {
+		Maker   ContractID
+		Amounts []int
+		Salt    []byte `abi:"salt"`
+	}
+
+	fromStructs, err := contractABI.Pack("submit", []order{
+		{Maker: ContractID{Contract: 7}, Amounts: []int{1, 2}, Salt: []byte{0xab}},
+	})
+	require.NoError(t, err)
+
+	fromMaps, err := contractABI.Pack("submit", []map[string]interface{}{
+		{"maker": "0x0000000000000000000000000000000000000007", "amounts": [2]uint64{1, 2}, "salt": "0xab"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fromStructs, fromMaps)
+
+	_, err = contractABI.Pack("submit", []order{{Maker: ContractID{Contract: 7}, Amounts: []int{1}}})
+	require.ErrorContains(t, err, "expected 2 elements")
+}
+
+func TestUnitContractABIUnpack(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+	method := contractABI.GetABI().Methods["matrix"]
+
+	output, err := method.Outputs.Pack([][]int32{{1, -2}, {}, {3}}, [4]byte{1, 2, 3, 4})
+	require.NoError(t, err)
+
+	values, err := contractABI.UnpackResult("matrix", ContractFunctionResult{ContractCallResult: output})
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	assert.Equal(t, [][]int32{{1, -2}, {}, {3}}, values[0])
+	assert.Equal(t, [4]byte{1, 2, 3, 4}, values[1])
+
+	var result struct {
+		Rows [][]int32
+		Tag  [4]byte
+	}
+	require.NoError(t, contractABI.UnpackInto(&result, "matrix", output))
+	assert.Equal(t, [][]int32{{1, -2}, {}, {3}}, result.Rows)
+}
+
+func TestUnitContractABIUnpackTuple(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+	method := contractABI.GetABI().Methods["order"]
+
+	input, err := _ABIConvert(method.Outputs[0].Type, map[string]interface{}{"maker": AccountID{Account: 9}, "amount": 12})
+	require.NoError(t, err)
+	output, err := method.Outputs.Pack(input.Interface())
+	require.NoError(t, err)
+
+	var order struct {
+		Maker  common.Address
+		Amount *big.Int
+	}
+	require.NoError(t, contractABI.UnpackInto(&order, "order", output))
+	assert.Equal(t, common.HexToAddress(AccountID{Account: 9}.ToSolidityAddress()), order.Maker)
+	assert.Equal(t, int64(12), order.Amount.Int64())
+}
+
+func TestUnitContractABIUnpackSingleAddress(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := ContractABIFromJSON([]byte(`[{"type":"function","name":"owner","inputs":[],"outputs":[{"name":"owner","type":"address"}],"stateMutability":"view"}]`))
+	require.NoError(t, err)
+	output, err := contractABI.GetABI().Methods["owner"].Outputs.Pack(common.HexToAddress(AccountID{Account: 9}.ToSolidityAddress()))
+	require.NoError(t, err)
+
+	var accountID AccountID
+	require.NoError(t, contractABI.UnpackInto(&accountID, "owner", output))
+	assert.Equal(t, AccountID{Account: 9}, accountID)
+
+	var tokenID TokenID
+	require.NoError(t, contractABI.UnpackInto(&tokenID, "owner", output))
+	assert.Equal(t, TokenID{Token: 9}, tokenID)
+
+	var result struct {
+		Owner AccountID
+	}
+	require.NoError(t, contractABI.UnpackInto(&result, "owner", output))
+	assert.Equal(t, AccountID{Account: 9}, result.Owner)
+
+	var address common.Address
+	require.NoError(t, contractABI.UnpackInto(&address, "owner", output))
+	assert.Equal(t, common.HexToAddress(AccountID{Account: 9}.ToSolidityAddress()), address)
+}
+
+func TestUnitContractABIUnpackAddressConversions(t *testing.T) {
+	t.Parallel()
+
+	type namedAddress common.Address
+
+	contractABI := _TestContractABI(t)
+	method := contractABI.GetABI().Methods["order"]
+	address := common.HexToAddress(AccountID{Account: 9}.ToSolidityAddress())
+
+	input, err := _ABIConvert(method.Outputs[0].Type, map[string]interface{}{"maker": AccountID{Account: 9}, "amount": 12})
+	require.NoError(t, err)
+	output, err := method.Outputs.Pack(input.Interface())
+	require.NoError(t, err)
+
+	var bytesOrder struct {
+		Maker [20]byte
+	}
+	require.NoError(t, contractABI.UnpackInto(&bytesOrder, "order", output))
+	assert.Equal(t, [20]byte(address), bytesOrder.Maker)
+
+	var namedOrder struct {
+		Maker namedAddress
+	}
+	require.NoError(t, contractABI.UnpackInto(&namedOrder, "order", output))
+	assert.Equal(t, namedAddress(address), namedOrder.Maker)
+
+	var anyOrder struct {
+		Maker interface{}
+	}
+	require.NoError(t, contractABI.UnpackInto(&anyOrder, "order", output))
+	assert.Equal(t, address, anyOrder.Maker)
+
+	var stringOrder struct {
+		Maker string
+	}
+	require.NoError(t, contractABI.UnpackInto(&stringOrder, "order", output))
+	assert.Equal(t, hex.EncodeToString(address.Bytes()), stringOrder.Maker)
+}
+
+func TestUnitContractABIUnpackTokenAddress(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := ContractABIFromJSON([]byte(`[{"type":"function","name":"token","inputs":[],"outputs":[{"name":"token","type":"address"}],"stateMutability":"view"}]`))
+	require.NoError(t, err)
+	output, err := contractABI.GetABI().Methods["token"].Outputs.Pack(common.HexToAddress("742d35cc6634c0532925a3b844bc454e4438f44e"))
+	require.NoError(t, err)
+
+	var tokenID TokenID
+	require.ErrorContains(t, contractABI.UnpackInto(&tokenID, "token", output), "not the long zero address of a token")
+
+	var contractID ContractID
+	require.NoError(t, contractABI.UnpackInto(&contractID, "token", output))
+	assert.Equal(t, "742d35cc6634c0532925a3b844bc454e4438f44e", hex.EncodeToString(contractID.EvmAddress))
+}
+
+func TestUnitContractABIPackConstructor(t *testing.T) {
+	t.Parallel()
+
+	data, err := _TestContractABI(t).PackConstructor(AccountID{Account: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000002", hex.EncodeToString(data))
+}
+
+func TestUnitContractABIUnpackLog(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+	event := contractABI.GetABI().Events["Transfer"]
+
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(42))
+	require.NoError(t, err)
+
+	from := common.HexToAddress("0x00000000000000000000000000000000000004d2")
+	memoHash := common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000abc")
+	log := ContractLogInfo{
+		Topics: [][]byte{event.ID.Bytes(), common.LeftPadBytes(from.Bytes(), 32), memoHash.Bytes()},
+		Data:   data,
+	}
+
+	values, err := contractABI.UnpackLog("Transfer", log)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{from, memoHash, big.NewInt(42)}, values)
+
+	values, err = contractABI.UnpackLog("Transfer(address,string,uint256)", log)
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+
+	log.Topics[0] = memoHash.Bytes()
+	_, err = contractABI.UnpackLog("Transfer", log)
+	require.ErrorContains(t, err, "not a Transfer(address,string,uint256) event")
+
+	_, err = contractABI.UnpackLog("Approval", log)
+	require.ErrorContains(t, err, "no event Approval")
+}
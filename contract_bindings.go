@@ -0,0 +1,518 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+// ContractBindingOptions describes the Go bindings GenerateContractBindings emits for a contract
+type ContractBindingOptions struct {
+	// Package is the name of the package of the generated file
+	Package string
+	// Type is the name of the binding type, such as "Token"
+	Type string
+	// ABI is the ABI JSON solc emits for the contract
+	ABI []byte
+	// Bytecode is the optional hex encoded bytecode of the contract, without which no deploy functions are emitted
+	Bytecode string
+}
+
+type _BindingArgument struct {
+	Name   string
+	GoType string
+}
+
+type _BindingMethod struct {
+	Name      string
+	GoName    string
+	Sig       string
+	Inputs    []_BindingArgument
+	Outputs   []_BindingArgument
+	Constant  bool
+	Payable   bool
+	InputList string
+}
+
+type _BindingEvent struct {
+	Name   string
+	Short  string
+	GoName string
+	Sig    string
+	Fields []_BindingArgument
+	Raw    string
+}
+
+type _BindingStruct struct {
+	GoName string
+	Sig    string
+	Fields []_BindingArgument
+}
+
+type _BindingData struct {
+	Package     string
+	Type        string
+	ABI         string
+	Bytecode    string
+	Constructor *_BindingMethod
+	Methods     []_BindingMethod
+	Events      []_BindingEvent
+	Structs     []*_BindingStruct
+}
+
+type _BindingGenerator struct {
+	typeName string
+	// the package level identifiers handed out so far
+	names   _BindingNames
+	structs map[string]*_BindingStruct
+	order   []*_BindingStruct
+}
+
+// GenerateContractBindings emits the gofmt formatted source of Go bindings for a contract: a deploy function using
+// ContractCreateFlow when the bytecode is known, a typed method per view or pure function calling it with
+// ContractCallQuery, a typed method per other function returning a ContractExecuteTransaction ready to be
+// executed, and a typed decoder of ContractLogInfo per event. Tuples become named structs.
+func GenerateContractBindings(options ContractBindingOptions) ([]byte, error) {
+	if !token.IsIdentifier(options.Package) {
+		return nil, fmt.Errorf("invalid package name %q", options.Package)
+	}
+	if !token.IsIdentifier(options.Type) || !token.IsExported(options.Type) {
+		return nil, fmt.Errorf("invalid type name %q", options.Type)
+	}
+
+	contractABI, err := ContractABIFromJSON(options.ABI)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing ABI")
+	}
+	parsed := contractABI.GetABI()
+
+	generator := _BindingGenerator{
+		typeName: options.Type,
+		names: _NewBindingNames(options.Type, options.Type+"ABI", options.Type+"Bytecode", "New"+options.Type,
+			"Deploy"+options.Type, "Deploy"+options.Type+"Flow"),
+		structs: make(map[string]*_BindingStruct),
+	}
+	data := _BindingData{
+		Package:  options.Package,
+		Type:     options.Type,
+		ABI:      fmt.Sprintf("%q", _CompactJSON(options.ABI)),
+		Bytecode: strings.TrimPrefix(strings.TrimSpace(options.Bytecode), "0x"),
+	}
+
+	// Event types are named before any tuple is, so tuple structs give way to them rather than the other way round
+	eventNames := make([]string, 0, len(parsed.Events))
+	for name := range parsed.Events {
+		eventNames = append(eventNames, name)
+	}
+	sort.Strings(eventNames)
+
+	eventGoNames := make(map[string]string, len(eventNames))
+	for _, name := range eventNames {
+		goName := options.Type + abi.ToCamelCase(name)
+		eventGoNames[name] = generator.names._Allocate(goName, goName)
+	}
+
+	if data.Bytecode != "" {
+		constructor := generator._Method(parsed.Constructor, "")
+		data.Constructor = &constructor
+	}
+
+	methodNames := make([]string, 0, len(parsed.Methods))
+	for name := range parsed.Methods {
+		methodNames = append(methodNames, name)
+	}
+	sort.Strings(methodNames)
+
+	reserved := map[string]bool{"ContractID": true, "CallGas": true}
+	for _, name := range methodNames {
+		method := generator._Method(parsed.Methods[name], name)
+		if reserved[method.GoName] {
+			method.GoName += "_"
+		}
+		data.Methods = append(data.Methods, method)
+	}
+
+	for _, name := range eventNames {
+		data.Events = append(data.Events, generator._Event(parsed.Events[name], name, eventGoNames[name]))
+	}
+
+	data.Structs = generator.order
+
+	var source bytes.Buffer
+	if err := _BindingTemplate.Execute(&source, data); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "formatting generated bindings")
+	}
+
+	return formatted, nil
+}
+
+func (generator *_BindingGenerator) _Method(method abi.Method, name string) _BindingMethod {
+	names := _NewBindingNames("binding", "client", "params", "result", "values", "err", "flow", "response", "receipt", "gas", "contractABI")
+
+	binding := _BindingMethod{
+		Name:     name,
+		GoName:   abi.ToCamelCase(name),
+		Sig:      method.Sig,
+		Constant: method.IsConstant(),
+		Payable:  method.IsPayable(),
+	}
+
+	list := make([]string, 0, len(method.Inputs))
+	for i, input := range method.Inputs {
+		argument := _BindingArgument{Name: names._Allocate(input.Name, fmt.Sprintf("arg%d", i)), GoType: generator._GoType(input.Type)}
+		binding.Inputs = append(binding.Inputs, argument)
+		list = append(list, argument.Name)
+	}
+	binding.InputList = strings.Join(list, ", ")
+
+	for i, output := range method.Outputs {
+		binding.Outputs = append(binding.Outputs, _BindingArgument{
+			Name:   names._Allocate(output.Name, fmt.Sprintf("out%d", i)),
+			GoType: generator._GoType(output.Type),
+		})
+	}
+
+	return binding
+}
+
+func (generator *_BindingGenerator) _Event(event abi.Event, name string, goName string) _BindingEvent {
+	names := _NewBindingNames()
+	binding := _BindingEvent{
+		Name:   name,
+		Short:  abi.ToCamelCase(name),
+		GoName: goName,
+		Sig:    event.Sig,
+	}
+
+	for i, input := range event.Inputs {
+		goType := generator._GoType(input.Type)
+		if input.Indexed && _BindingHashedTopic(input.Type) {
+			goType = "common.Hash"
+		}
+
+		binding.Fields = append(binding.Fields, _BindingArgument{
+			Name:   names._Allocate(abi.ToCamelCase(input.Name), fmt.Sprintf("Arg%d", i)),
+			GoType: goType,
+		})
+	}
+	binding.Raw = names._Allocate("Raw", "Raw")
+
+	return binding
+}
+
+// _GoType returns the Go type go-ethereum decodes the ABI type into, naming tuples
+func (generator *_BindingGenerator) _GoType(t abi.Type) string {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		prefix := "int"
+		if t.T == abi.UintTy {
+			prefix = "uint"
+		}
+		switch t.Size {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%s%d", prefix, t.Size)
+		}
+		return "*big.Int"
+	case abi.BoolTy:
+		return "bool"
+	case abi.StringTy:
+		return "string"
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.HashTy:
+		return "common.Hash"
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size)
+	case abi.FunctionTy:
+		return "[24]byte"
+	case abi.SliceTy:
+		return "[]" + generator._GoType(*t.Elem)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]%s", t.Size, generator._GoType(*t.Elem))
+	case abi.TupleTy:
+		return generator._Struct(t).GoName
+	}
+
+	return "interface{}"
+}
+
+func (generator *_BindingGenerator) _Struct(t abi.Type) *_BindingStruct {
+	key := t.TupleRawName + t.String()
+	if existing, ok := generator.structs[key]; ok {
+		return existing
+	}
+
+	fallback := fmt.Sprintf("%sTuple%d", generator.typeName, len(generator.order))
+	name := fallback
+	if t.TupleRawName != "" {
+		name = abi.ToCamelCase(t.TupleRawName)
+	}
+
+	binding := &_BindingStruct{GoName: generator.names._Allocate(name, fallback), Sig: t.String()}
+	generator.structs[key] = binding
+	generator.order = append(generator.order, binding)
+
+	for i, element := range t.TupleElems {
+		binding.Fields = append(binding.Fields, _BindingArgument{
+			Name:   abi.ToCamelCase(t.TupleRawNames[i]),
+			GoType: generator._GoType(*element),
+		})
+	}
+
+	return binding
+}
+
+// _BindingHashedTopic reports whether an indexed parameter of the type is recorded as the hash of its value
+func _BindingHashedTopic(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+
+	return false
+}
+
+// _BindingNames hands out unique Go identifiers
+type _BindingNames map[string]bool
+
+func _NewBindingNames(reserved ...string) _BindingNames {
+	names := make(_BindingNames)
+	for _, name := range reserved {
+		names[name] = true
+	}
+
+	return names
+}
+
+func (names _BindingNames) _Allocate(name string, fallback string) string {
+	name = strings.Trim(name, "_")
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+	if name == "" || !token.IsIdentifier(name) {
+		name = fallback
+	}
+	if !token.IsExported(fallback) {
+		runes := []rune(name)
+		runes[0] = unicode.ToLower(runes[0])
+		name = string(runes)
+	}
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+
+	for names[name] {
+		name += "_"
+	}
+	names[name] = true
+
+	return name
+}
+
+// _CompactJSON strips the insignificant whitespace of the ABI JSON embedded in the bindings
+func _CompactJSON(data []byte) string {
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return string(data)
+	}
+
+	return compact.String()
+}
+
+var _BindingTemplate = template.Must(template.New("bindings").Parse(`// Code generated by hedera-abigen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = abi.ConvertType
+	_ = common.Big1
+)
+
+// {{.Type}}ABI is the ABI JSON of {{.Type}}
+const {{.Type}}ABI = {{.ABI}}
+{{if .Bytecode}}
+// {{.Type}}Bytecode is the hex encoded bytecode of {{.Type}}
+const {{.Type}}Bytecode = "{{.Bytecode}}"
+{{end}}
+// {{.Type}} binds a deployed {{.Type}} contract
+type {{.Type}} struct {
+	ContractID hedera.ContractID
+	// CallGas is the gas of the ContractCallQuery of the read methods
+	CallGas uint64
+
+	abi *hedera.ContractABI
+}
+
+// New{{.Type}} binds the {{.Type}} contract deployed at contractID
+func New{{.Type}}(contractID hedera.ContractID) (*{{.Type}}, error) {
+	contractABI, err := hedera.ContractABIFromJSON([]byte({{.Type}}ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{.Type}}{ContractID: contractID, CallGas: 100000, abi: contractABI}, nil
+}
+{{with .Constructor}}
+// Deploy{{$.Type}}Flow returns a ContractCreateFlow deploying {{$.Type}} with the constructor arguments
+func Deploy{{$.Type}}Flow({{range .Inputs}}{{.Name}} {{.GoType}}, {{end}}) (*hedera.ContractCreateFlow, error) {
+	contractABI, err := hedera.ContractABIFromJSON([]byte({{$.Type}}ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := contractABI.PackConstructor({{.InputList}})
+	if err != nil {
+		return nil, err
+	}
+
+	return hedera.NewContractCreateFlow().
+		SetBytecodeWithString({{$.Type}}Bytecode).
+		SetConstructorParametersRaw(params), nil
+}
+
+// Deploy{{$.Type}} deploys {{$.Type}} with the gas and constructor arguments and binds the created contract
+func Deploy{{$.Type}}(client *hedera.Client, gas int64, {{range .Inputs}}{{.Name}} {{.GoType}}, {{end}}) (*{{$.Type}}, error) {
+	flow, err := Deploy{{$.Type}}Flow({{.InputList}})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := flow.SetGas(gas).Execute(client)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := response.GetReceipt(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.ContractID == nil {
+		return nil, errors.New("receipt of the deployment has no contract ID")
+	}
+
+	return New{{$.Type}}(*receipt.ContractID)
+}
+{{end}}
+{{range .Structs}}
+// {{.GoName}} is the {{.Sig}} tuple
+type {{.GoName}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+{{end}}
+{{range .Methods}}{{if .Constant}}
+// {{.GoName}} calls {{.Sig}} with ContractCallQuery
+func (binding *{{$.Type}}) {{.GoName}}(client *hedera.Client, {{range .Inputs}}{{.Name}} {{.GoType}}, {{end}}) ({{range .Outputs}}{{.Name}} {{.GoType}}, {{end}}err error) {
+	params, err := binding.abi.Pack("{{.Name}}", {{.InputList}})
+	if err != nil {
+		return
+	}
+
+	{{if .Outputs}}result{{else}}_{{end}}, err {{if .Outputs}}:{{end}}= hedera.NewContractCallQuery().
+		SetContractID(binding.ContractID).
+		SetGas(binding.CallGas).
+		SetFunctionParameters(params).
+		Execute(client)
+{{- if .Outputs}}
+	if err != nil {
+		return
+	}
+
+	values, err := binding.abi.UnpackResult("{{.Name}}", result)
+	if err != nil {
+		return
+	}
+{{range $i, $output := .Outputs}}
+	{{$output.Name}} = *abi.ConvertType(values[{{$i}}], new({{$output.GoType}})).(*{{$output.GoType}})
+{{- end}}
+{{else}}
+{{end}}
+	return
+}
+{{else}}
+// {{.GoName}} returns a ContractExecuteTransaction calling {{.Sig}}{{if .Payable}}, which is payable with SetPayableAmount{{end}}
+func (binding *{{$.Type}}) {{.GoName}}({{range .Inputs}}{{.Name}} {{.GoType}}, {{end}}) (*hedera.ContractExecuteTransaction, error) {
+	params, err := binding.abi.Pack("{{.Name}}", {{.InputList}})
+	if err != nil {
+		return nil, err
+	}
+
+	return hedera.NewContractExecuteTransaction().
+		SetContractID(binding.ContractID).
+		SetFunctionParameters(params), nil
+}
+{{end}}{{end}}
+{{range .Events}}
+// {{.GoName}} is the {{.Sig}} event of {{$.Type}}
+type {{.GoName}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+	{{.Raw}} hedera.ContractLogInfo
+}
+
+// Parse{{.Short}} decodes the {{.Sig}} event from the log
+func (binding *{{$.Type}}) Parse{{.Short}}(log hedera.ContractLogInfo) (*{{.GoName}}, error) {
+	{{if .Fields}}values{{else}}_{{end}}, err := binding.abi.UnpackLog("{{.Name}}", log)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &{{.GoName}}{ {{- .Raw}}: log}
+{{- range $i, $field := .Fields}}
+	event.{{$field.Name}} = *abi.ConvertType(values[{{$i}}], new({{$field.GoType}})).(*{{$field.GoType}})
+{{- end}}
+
+	return event, nil
+}
+{{end}}
+`))
@@ -0,0 +1,127 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitGenerateContractBindings(t *testing.T) {
+	t.Parallel()
+
+	source, err := GenerateContractBindings(ContractBindingOptions{
+		Package:  "token",
+		Type:     "Token",
+		ABI:      []byte(testContractABI),
+		Bytecode: "0x6080",
+	})
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "token.go", source, 0)
+	require.NoError(t, err)
+	_VetGeneratedBindings(t, source)
+
+	generated := string(source)
+	assert.Contains(t, generated, `const TokenBytecode = "6080"`)
+	assert.Contains(t, generated, "func DeployTokenFlow(owner common.Address) (*hedera.ContractCreateFlow, error)")
+	assert.Contains(t, generated, "func DeployToken(client *hedera.Client, gas int64, owner common.Address) (*Token, error)")
+	assert.Contains(t, generated, "func (binding *Token) Transfer(to common.Address, amount *big.Int) (*hedera.ContractExecuteTransaction, error)")
+	assert.Contains(t, generated, "func (binding *Token) Set(value uint8) (*hedera.ContractExecuteTransaction, error)")
+	assert.Contains(t, generated, "func (binding *Token) Set0(value string) (*hedera.ContractExecuteTransaction, error)")
+	assert.Contains(t, generated, "func (binding *Token) Submit(orders []TokenTuple1) (*hedera.ContractExecuteTransaction, error)")
+	assert.Contains(t, generated, "func (binding *Token) Matrix(client *hedera.Client) (rows [][]int32, tag [4]byte, err error)")
+	assert.Contains(t, generated, "func (binding *Token) Order(client *hedera.Client) (order TokenTuple0, err error)")
+	assert.Contains(t, generated, "func (binding *Token) ParseTransfer(log hedera.ContractLogInfo) (*TokenTransfer, error)")
+	assert.Contains(t, generated, "Memo  common.Hash")
+	assert.Contains(t, generated, "func (binding *Token) ParsePaused(log hedera.ContractLogInfo) (*TokenPaused, error)")
+}
+
+// The source importer type checks this module and its dependencies once, then caches them, but isn't safe for
+// concurrent use
+var (
+	_bindingsImporterMu sync.Mutex
+	_bindingsFset       = token.NewFileSet()
+	_bindingsImporter   = importer.ForCompiler(_bindingsFset, "source", nil)
+)
+
+// _VetGeneratedBindings type checks the generated source in memory against this module and its dependencies
+func _VetGeneratedBindings(t *testing.T, source []byte) {
+	if testing.Short() {
+		t.Skip("type checking the module from source is slow")
+	}
+
+	_bindingsImporterMu.Lock()
+	defer _bindingsImporterMu.Unlock()
+
+	file, err := parser.ParseFile(_bindingsFset, "token.go", source, 0)
+	require.NoError(t, err)
+
+	config := types.Config{Importer: _bindingsImporter}
+	_, err = config.Check("token", _bindingsFset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+}
+
+func TestUnitGenerateContractBindingsWithoutBytecode(t *testing.T) {
+	t.Parallel()
+
+	source, err := GenerateContractBindings(ContractBindingOptions{Package: "token", Type: "Token", ABI: []byte(testContractABI)})
+	require.NoError(t, err)
+	assert.NotContains(t, string(source), "DeployToken")
+	assert.NotContains(t, string(source), "TokenBytecode")
+	_VetGeneratedBindings(t, source)
+
+	_, err = GenerateContractBindings(ContractBindingOptions{Package: "token", Type: "token", ABI: []byte(testContractABI)})
+	require.ErrorContains(t, err, "invalid type name")
+
+	_, err = GenerateContractBindings(ContractBindingOptions{Package: "token", Type: "Token", ABI: []byte("{")})
+	require.Error(t, err)
+}
+
+func TestUnitGenerateContractBindingsStructNames(t *testing.T) {
+	t.Parallel()
+
+	contractABI := `[
+	{"type":"function","name":"get","inputs":[],"outputs":[{"name":"token","type":"tuple","internalType":"struct Token","components":[{"name":"id","type":"uint64"}]}],"stateMutability":"view"},
+	{"type":"function","name":"put","inputs":[{"name":"transfer","type":"tuple","internalType":"struct TokenTransfer","components":[{"name":"amount","type":"uint64"}]}],"outputs":[]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"amount","type":"uint64","indexed":false}]}
+]`
+
+	source, err := GenerateContractBindings(ContractBindingOptions{Package: "token", Type: "Token", ABI: []byte(contractABI)})
+	require.NoError(t, err)
+	_VetGeneratedBindings(t, source)
+
+	generated := string(source)
+	assert.Contains(t, generated, "type TokenTransfer struct")
+	assert.Contains(t, generated, "type Token_ struct")
+	assert.Contains(t, generated, "type TokenTransfer_ struct")
+}
@@ -0,0 +1,104 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// _LibraryPlaceholderLength is the length of the placeholder solc leaves in hex encoded bytecode
+// for each reference to an external library, as long as the 20 byte address replacing it
+const _LibraryPlaceholderLength = 40
+
+// LinkContractBytecode replaces the library placeholders solc leaves in the hex encoded bytecode of a
+// contract with the EVM addresses of the deployed libraries. The libraries are keyed by their fully
+// qualified name, for example "contracts/Math.sol:Math", which the `__$<hash>$__` placeholders of solc
+// 0.5 and later are derived from. The `__Math____...` placeholders of older compilers also match the
+// plain library name. An error is returned when any placeholder is left unlinked.
+func LinkContractBytecode(bytecode string, libraries map[string]ContractID) (string, error) {
+	names := make([]string, 0, len(libraries))
+	for name := range libraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var linked strings.Builder
+	var unlinked []string
+	rest := bytecode
+	for {
+		i := strings.Index(rest, "__")
+		if i < 0 {
+			break
+		}
+
+		offset := len(bytecode) - len(rest) + i
+		if len(rest) < i+_LibraryPlaceholderLength || rest[i+_LibraryPlaceholderLength-2:i+_LibraryPlaceholderLength] != "__" {
+			return "", errors.Errorf("malformed library placeholder at offset %d", offset)
+		}
+		placeholder := rest[i : i+_LibraryPlaceholderLength]
+
+		linked.WriteString(rest[:i])
+		name := ""
+		for _, candidate := range names {
+			if _LibraryPlaceholderMatches(placeholder, candidate) {
+				name = candidate
+				break
+			}
+		}
+		if name == "" {
+			linked.WriteString(placeholder)
+			unlinked = append(unlinked, strings.TrimRight(placeholder[2:], "_"))
+		} else {
+			linked.WriteString(hex.EncodeToString(_ContractEvmAddress(libraries[name])))
+		}
+
+		rest = rest[i+_LibraryPlaceholderLength:]
+	}
+	linked.WriteString(rest)
+
+	if len(unlinked) > 0 {
+		return "", errors.Errorf("bytecode references unlinked libraries: %s", strings.Join(unlinked, ", "))
+	}
+
+	return linked.String(), nil
+}
+
+// _LibraryPlaceholderMatches reports whether the placeholder stands for the library with the given name
+func _LibraryPlaceholderMatches(placeholder string, name string) bool {
+	inner := placeholder[2 : _LibraryPlaceholderLength-2]
+	if inner[0] == '$' && inner[len(inner)-1] == '$' {
+		hash := hex.EncodeToString(crypto.Keccak256([]byte(name)))
+		return strings.EqualFold(inner[1:len(inner)-1], hash[:len(inner)-2])
+	}
+
+	// older compilers truncate the name to fit the placeholder and pad it with underscores
+	inner = strings.TrimRight(inner, "_")
+	if len(name) > _LibraryPlaceholderLength-4 {
+		name = strings.TrimRight(name[:_LibraryPlaceholderLength-4], "_")
+	}
+
+	return inner == name || strings.HasSuffix(inner, ":"+name)
+}
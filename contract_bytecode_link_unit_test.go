@@ -0,0 +1,98 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestLibraryPlaceholder(name string) string {
+	return "__$" + hex.EncodeToString(crypto.Keccak256([]byte(name)))[:34] + "$__"
+}
+
+func _TestLegacyLibraryPlaceholder(name string) string {
+	return "__" + name + strings.Repeat("_", 36-len(name)) + "__"
+}
+
+func TestUnitLinkContractBytecode(t *testing.T) {
+	t.Parallel()
+
+	bytecode := "6080" + _TestLibraryPlaceholder("contracts/Math.sol:Math") + "6040" +
+		_TestLibraryPlaceholder("contracts/Math.sol:Math") + "60" + _TestLegacyLibraryPlaceholder("Strings") + "00"
+
+	_, err := LinkContractBytecode(bytecode, nil)
+	require.ErrorContains(t, err, "unlinked libraries")
+
+	_, err = LinkContractBytecode(bytecode, map[string]ContractID{"contracts/Math.sol:Math": {Contract: 1234}})
+	require.ErrorContains(t, err, "Strings")
+
+	linked, err := LinkContractBytecode(bytecode, map[string]ContractID{
+		"contracts/Math.sol:Math": {Contract: 1234},
+		"Strings":                 {EvmAddress: []byte(strings.Repeat("\xab", 20))},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "6080"+"00000000000000000000000000000000000004d2"+"6040"+
+		"00000000000000000000000000000000000004d2"+"60"+strings.Repeat("ab", 20)+"00", linked)
+	_, err = hex.DecodeString(linked)
+	require.NoError(t, err)
+
+	linked, err = LinkContractBytecode("6080"+_TestLegacyLibraryPlaceholder("contracts/Strings.sol:Strings")+"60", map[string]ContractID{"Strings": {Contract: 5}})
+	require.NoError(t, err)
+	require.Equal(t, "6080000000000000000000000000000000000000000560", linked)
+
+	_, err = LinkContractBytecode("6080__Math__", nil)
+	require.ErrorContains(t, err, "malformed")
+}
+
+func TestUnitContractCreateFlowLinkLibrary(t *testing.T) {
+	t.Parallel()
+
+	flow := NewContractCreateFlow().
+		SetBytecodeWithString("0x6080" + _TestLibraryPlaceholder("contracts/Math.sol:Math") + "00")
+	require.Equal(t, "6080"+_TestLibraryPlaceholder("contracts/Math.sol:Math")+"00", flow.GetBytecode())
+	require.Error(t, flow._LinkLibraries())
+
+	flow.LinkLibrary("contracts/Math.sol:Math", ContractID{Contract: 1234})
+	require.Equal(t, map[string]ContractID{"contracts/Math.sol:Math": {Contract: 1234}}, flow.GetLibraries())
+	require.NoError(t, flow._LinkLibraries())
+	require.Equal(t, "608000000000000000000000000000000000000004d200", string(flow.bytecode))
+	require.Equal(t, "608000000000000000000000000000000000000004d200", flow.GetBytecode())
+
+	raw := NewContractCreateFlow().SetBytecode([]byte{0x60, 0x80, 0x00})
+	require.NoError(t, raw._LinkLibraries())
+	require.Equal(t, []byte{0x60, 0x80, 0x00}, raw.bytecode)
+	raw.LinkLibrary("Math", ContractID{Contract: 1})
+	require.Error(t, raw._LinkLibraries())
+
+	// printable raw bytecode is still raw bytecode
+	printable := NewContractCreateFlow().SetBytecode([]byte("`@`@R"))
+	require.Equal(t, "6040604052", printable.GetBytecode())
+	require.NoError(t, printable._LinkLibraries())
+	require.Equal(t, []byte("`@`@R"), printable.bytecode)
+}
@@ -21,6 +21,9 @@ package hedera
  */
 
 import (
+	"context"
+	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -41,6 +44,8 @@ type ContractCallQuery struct {
 	maxResultSize      uint64
 	functionParameters []byte
 	senderID           *AccountID
+	block              string
+	fromEvmAddress     string
 }
 
 // NewContractCallQuery creates a ContractCallQuery query which can be used to construct and execute a
@@ -146,6 +151,79 @@ func (q *ContractCallQuery) Execute(client *Client) (ContractFunctionResult, err
 	return _ContractFunctionResultFromProtobuf(resp.GetContractCallLocal().FunctionResult), nil
 }
 
+// SetBlock sets the block ExecuteViaMirror and EstimateGas run the call against: "latest", "earliest",
+// "pending" or a block number. Defaults to "latest".
+func (q *ContractCallQuery) SetBlock(block string) *ContractCallQuery {
+	q.block = block
+	return q
+}
+
+// GetBlock returns the block ExecuteViaMirror and EstimateGas run the call against
+func (q *ContractCallQuery) GetBlock() string {
+	return q.block
+}
+
+// SetFromEvmAddress sets the EVM address ExecuteViaMirror and EstimateGas use as the sender of the call,
+// for accounts whose EVM address is an alias rather than derived from the AccountID.
+// When not set the sender ID is used, or else the client operator.
+func (q *ContractCallQuery) SetFromEvmAddress(evmAddress string) *ContractCallQuery {
+	q.fromEvmAddress = evmAddress
+	return q
+}
+
+// GetFromEvmAddress returns the EVM address ExecuteViaMirror and EstimateGas use as the sender of the call
+func (q *ContractCallQuery) GetFromEvmAddress() string {
+	return q.fromEvmAddress
+}
+
+// ExecuteViaMirror simulates the call with the client's mirror node instead of a consensus node.
+// No query payment is made. The result carries no gas used, logs or bloom.
+func (q *ContractCallQuery) ExecuteViaMirror(client *Client) (ContractFunctionResult, error) {
+	if client == nil {
+		return ContractFunctionResult{}, errNoClientProvided
+	}
+
+	result, err := MirrorClientFromClient(client).CallContract(context.Background(), q._MirrorCall(client))
+	if err != nil {
+		return ContractFunctionResult{}, err
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(result.Result, "0x"))
+	if err != nil {
+		return ContractFunctionResult{}, err
+	}
+
+	return ContractFunctionResult{
+		ContractID:         q.contractID,
+		ContractCallResult: data,
+		GasAvailable:       int64(q.gas),
+		FunctionParameters: q.functionParameters,
+	}, nil
+}
+
+// EstimateGas estimates the gas the call needs with the client's mirror node
+func (q *ContractCallQuery) EstimateGas(client *Client) (uint64, error) {
+	return _MirrorEstimateGas(client, q._MirrorCall(client), 0)
+}
+
+func (q *ContractCallQuery) _MirrorCall(client *Client) MirrorContractCall {
+	call := MirrorContractCall{
+		Block: q.block,
+		Data:  _MirrorHex(q.functionParameters),
+		From:  q.fromEvmAddress,
+		Gas:   int64(q.gas),
+		To:    _MirrorContractAddress(q.GetContractID()),
+	}
+	if call.From == "" && q.senderID != nil {
+		call.From = "0x" + q.senderID.ToSolidityAddress()
+	}
+	if call.From == "" && client != nil && client.operator != nil {
+		call.From = "0x" + client.operator.accountID.ToSolidityAddress()
+	}
+
+	return call
+}
+
 // SetMaxQueryPayment sets the maximum payment allowed for this Query.
 func (q *ContractCallQuery) SetMaxQueryPayment(maxPayment Hbar) *ContractCallQuery {
 	q.Query.SetMaxQueryPayment(maxPayment)
@@ -206,6 +284,19 @@ func (q *ContractCallQuery) getName() string {
 	return "ContractCallQuery"
 }
 
+func (q *ContractCallQuery) mapStatusError(_ Executable, response interface{}) error {
+	err := ErrHederaPreCheckStatus{
+		Status: Status(response.(*services.Response).GetContractCallLocal().GetHeader().GetNodeTransactionPrecheckCode()),
+	}
+	if err.Status == StatusContractRevertExecuted {
+		if functionResult := response.(*services.Response).GetContractCallLocal().GetFunctionResult(); functionResult != nil {
+			err.Revert = _ContractFunctionResultFromProtobuf(functionResult).GetRevertError()
+		}
+	}
+
+	return err
+}
+
 func (q *ContractCallQuery) buildQuery() *services.Query {
 	pb := services.Query_ContractCallLocal{
 		ContractCallLocal: &services.ContractCallLocalQuery{
@@ -25,6 +25,9 @@ package hedera
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -160,3 +163,75 @@ func TestUnitContractCallQuerySetNothing(t *testing.T) {
 	balance.GetQueryPayment()
 	balance.GetMaxQueryPayment()
 }
+
+func TestUnitContractCallQueryExecuteViaMirror(t *testing.T) {
+	t.Parallel()
+
+	var calls []MirrorContractCall
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/contracts/call", r.URL.Path)
+
+		var call MirrorContractCall
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&call))
+		calls = append(calls, call)
+
+		if call.Estimate {
+			_, _ = w.Write([]byte(`{"result":"0x5208"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"0x000000000000000000000000000000000000000000000000000000000000002a"}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	query := NewContractCallQuery().
+		SetContractID(ContractID{Contract: 1234}).
+		SetGas(100000).
+		SetFunction("getNumber", nil).
+		SetBlock("latest")
+
+	result, err := query.ExecuteViaMirror(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), result.GetUint64(0))
+	require.Equal(t, ContractID{Contract: 1234}, *result.ContractID)
+
+	gas, err := query.SetFromEvmAddress("0x00000000000000000000000000000000000003e8").EstimateGas(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), gas)
+
+	require.Len(t, calls, 2)
+	require.Equal(t, "0x"+ContractID{Contract: 1234}.ToSolidityAddress(), calls[0].To)
+	require.Equal(t, "0x"+AccountID{Account: 2}.ToSolidityAddress(), calls[0].From)
+	require.Equal(t, "latest", calls[0].Block)
+	require.Equal(t, _MirrorHex(query.GetFunctionParameters()), calls[0].Data)
+	require.False(t, calls[0].Estimate)
+	require.Equal(t, "0x00000000000000000000000000000000000003e8", calls[1].From)
+	require.True(t, calls[1].Estimate)
+}
+
+func TestUnitContractCallQueryExecuteViaMirrorRevert(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"CONTRACT_REVERT_EXECUTED","detail":"","data":"0x08c379a0"}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	_, err = NewContractCallQuery().
+		SetContractID(ContractID{Contract: 1234}).
+		ExecuteViaMirror(client)
+
+	var mirrorErr ErrMirrorNodeResponse
+	require.ErrorAs(t, err, &mirrorErr)
+	require.Equal(t, []string{"CONTRACT_REVERT_EXECUTED"}, mirrorErr.Messages)
+	require.Equal(t, "0x08c379a0", mirrorErr.Data)
+}
@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -42,6 +43,9 @@ type ContractCreateFlow struct {
 	autoRenewAccountID            *AccountID
 	maxAutomaticTokenAssociations int32
 	maxChunks                     *uint64
+	gasEstimateMargin             *uint64
+	libraries                     map[string]ContractID
+	bytecodeIsText                bool
 }
 
 // NewContractCreateFlow creates a new ContractCreateFlow transaction builder object.
@@ -57,9 +61,16 @@ func NewContractCreateFlow() *ContractCreateFlow {
 }
 
 // SetBytecodeWithString sets the bytecode of the contract in hex-encoded string format.
+// Bytecode with unlinked library placeholders is kept as text until the libraries are linked.
 func (tx *ContractCreateFlow) SetBytecodeWithString(bytecode string) *ContractCreateFlow {
 	tx._RequireNotFrozen()
+	if strings.Contains(bytecode, "__") {
+		tx.bytecode = []byte(strings.TrimPrefix(bytecode, "0x"))
+		tx.bytecodeIsText = true
+		return tx
+	}
 	tx.bytecode, _ = hex.DecodeString(bytecode)
+	tx.bytecodeIsText = false
 	return tx
 }
 
@@ -67,14 +78,35 @@ func (tx *ContractCreateFlow) SetBytecodeWithString(bytecode string) *ContractCr
 func (tx *ContractCreateFlow) SetBytecode(bytecode []byte) *ContractCreateFlow {
 	tx._RequireNotFrozen()
 	tx.bytecode = bytecode
+	tx.bytecodeIsText = false
 	return tx
 }
 
 // GetBytecode returns the hex-encoded bytecode of the contract.
 func (tx *ContractCreateFlow) GetBytecode() string {
+	if tx.bytecodeIsText {
+		return string(tx.bytecode)
+	}
 	return hex.EncodeToString(tx.bytecode)
 }
 
+// LinkLibrary sets the deployed library replacing the library placeholders of the hex encoded bytecode
+// set with SetBytecodeWithString before it is uploaded. The name is the fully qualified library name solc derives the placeholders from,
+// for example "contracts/Math.sol:Math". Execute fails when a placeholder is left unlinked.
+func (tx *ContractCreateFlow) LinkLibrary(name string, contractID ContractID) *ContractCreateFlow {
+	tx._RequireNotFrozen()
+	if tx.libraries == nil {
+		tx.libraries = make(map[string]ContractID)
+	}
+	tx.libraries[name] = contractID
+	return tx
+}
+
+// GetLibraries returns the deployed libraries linked into the bytecode by their name
+func (tx *ContractCreateFlow) GetLibraries() map[string]ContractID {
+	return tx.libraries
+}
+
 // Sets the state of the instance and its fields can be modified arbitrarily if this key signs a transaction
 // to modify it. If this is null, then such modifications are not possible, and there is no administrator
 // that can override the normal operation of this smart contract instance. Note that if it is created with no
@@ -107,6 +139,23 @@ func (tx *ContractCreateFlow) GetGas() int64 {
 	return tx.gas
 }
 
+// SetGasFromEstimate makes Execute estimate the gas of the deployment with the client's mirror node
+// and set the gas to the estimate plus marginPercent percent, replacing any gas set.
+func (tx *ContractCreateFlow) SetGasFromEstimate(marginPercent uint64) *ContractCreateFlow {
+	tx._RequireNotFrozen()
+	tx.gasEstimateMargin = &marginPercent
+	return tx
+}
+
+// GetGasFromEstimate returns the safety margin added to the estimated gas and whether gas is estimated
+func (tx *ContractCreateFlow) GetGasFromEstimate() (uint64, bool) {
+	if tx.gasEstimateMargin == nil {
+		return 0, false
+	}
+
+	return *tx.gasEstimateMargin, true
+}
+
 // SetInitialBalance sets the initial number of hbars to put into the cryptocurrency account
 // associated with and owned by the smart contract.
 func (tx *ContractCreateFlow) SetInitialBalance(initialBalance Hbar) *ContractCreateFlow {
@@ -316,7 +365,55 @@ func (tx *ContractCreateFlow) _CreateTransactionReceiptQuery(response Transactio
 		SetTransactionID(response.TransactionID)
 }
 
+func (tx *ContractCreateFlow) _LinkLibraries() error {
+	if !tx.bytecodeIsText {
+		if len(tx.libraries) > 0 {
+			return errors.New("libraries can only be linked into bytecode with placeholders set with SetBytecodeWithString")
+		}
+		return nil
+	}
+
+	bytecode, err := LinkContractBytecode(string(tx.bytecode), tx.libraries)
+	if err != nil {
+		return err
+	}
+	tx.bytecode = []byte(bytecode)
+
+	return nil
+}
+
+func (tx *ContractCreateFlow) _ApplyGasEstimate(client *Client) error {
+	if tx.gasEstimateMargin == nil {
+		return nil
+	}
+
+	// The bytecode is usually given as hex, which is also how it is stored in the file
+	bytecode := strings.TrimPrefix(string(tx.bytecode), "0x")
+	if _, err := hex.DecodeString(bytecode); err != nil {
+		bytecode = hex.EncodeToString(tx.bytecode)
+	}
+
+	call := MirrorContractCall{
+		Data:  "0x" + bytecode + hex.EncodeToString(tx.parameters),
+		Value: tx.initialBalance,
+	}
+
+	gas, err := _MirrorEstimateGas(client, call, *tx.gasEstimateMargin)
+	if err != nil {
+		return err
+	}
+	tx.gas = int64(gas)
+
+	return nil
+}
+
 func (tx *ContractCreateFlow) Execute(client *Client) (TransactionResponse, error) {
+	if err := tx._LinkLibraries(); err != nil {
+		return TransactionResponse{}, err
+	}
+	if err := tx._ApplyGasEstimate(client); err != nil {
+		return TransactionResponse{}, err
+	}
 	tx.splitBytecode()
 
 	fileCreateResponse, err := tx._CreateFileCreateTransaction(client).Execute(client)
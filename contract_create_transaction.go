@@ -453,6 +453,18 @@ func (tx *ContractCreateTransaction) Execute(client *Client) (TransactionRespons
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the ContractCreateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *ContractCreateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed ContractCreateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *ContractCreateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *ContractCreateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -256,6 +256,18 @@ func (tx *ContractDeleteTransaction) Execute(client *Client) (TransactionRespons
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the ContractDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *ContractDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed ContractDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *ContractDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *ContractDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -0,0 +1,398 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// ContractEvent is a contract log decoded by the event of a ContractABI it was emitted for
+type ContractEvent struct {
+	// Name is the name of the event in the ABI, suffixed like "Transfer0" for overloads
+	Name string
+	// Signature is the canonical signature of the event, such as "Transfer(address,address,uint256)"
+	Signature  string
+	ContractID ContractID
+	// Values are the decoded parameters in the order the event declares them
+	Values []interface{}
+	// Args are the decoded parameters by name, leaving out unnamed parameters
+	Args map[string]interface{}
+	// ConsensusTimestamp is the consensus time of the log, known only for logs from the mirror node
+	ConsensusTimestamp time.Time
+	Log                ContractLogInfo
+}
+
+// DecodeLog decodes the log by the event its first topic identifies. Anonymous events have no such topic and can
+// only be decoded by name with UnpackLog.
+func (contractABI *ContractABI) DecodeLog(log ContractLogInfo) (ContractEvent, error) {
+	if len(log.Topics) == 0 {
+		return ContractEvent{}, errors.New("log has no topics")
+	}
+
+	for name, event := range contractABI.abi.Events {
+		if !event.Anonymous && bytes.Equal(log.Topics[0], event.ID.Bytes()) {
+			return contractABI._DecodeLog(name, event, log)
+		}
+	}
+
+	return ContractEvent{}, fmt.Errorf("no event with topic 0x%s in ABI", hex.EncodeToString(log.Topics[0]))
+}
+
+func (contractABI *ContractABI) _DecodeLog(name string, event abi.Event, log ContractLogInfo) (ContractEvent, error) {
+	values, err := contractABI.UnpackLog(name, log)
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	args := make(map[string]interface{}, len(values))
+	for i, input := range event.Inputs {
+		if input.Name != "" {
+			args[input.Name] = values[i]
+		}
+	}
+
+	return ContractEvent{
+		Name:       name,
+		Signature:  event.Sig,
+		ContractID: log.ContractID,
+		Values:     values,
+		Args:       args,
+		Log:        log,
+	}, nil
+}
+
+// BloomContains reports whether the bloom filter of the log may record the topic or address. False positives are
+// possible while false negatives are not, so a log without a bloom filter may contain anything.
+func (logInfo ContractLogInfo) BloomContains(value []byte) bool {
+	return _BloomContains(logInfo.Bloom, value)
+}
+
+// _BloomContains checks the three bits the Keccak-256 hash of the value sets in a 2048 bit Ethereum bloom filter
+func _BloomContains(bloom []byte, value []byte) bool {
+	if len(bloom) != 256 {
+		return true
+	}
+
+	hash := crypto.Keccak256(value)
+	for i := 0; i < 6; i += 2 {
+		bit := (uint(hash[i])<<8 | uint(hash[i+1])) & 2047
+		if bloom[255-bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContractEventFilter selects and decodes the logs of the events of a ContractABI, optionally only those emitted by
+// some contracts or for some of the events
+type ContractEventFilter struct {
+	abi         *ContractABI
+	contractIDs []ContractID
+	events      map[string]abi.Event
+	err         error
+}
+
+// NewContractEventFilter creates a filter matching every event of the ABI emitted by any contract
+func NewContractEventFilter(contractABI *ContractABI) *ContractEventFilter {
+	return &ContractEventFilter{abi: contractABI}
+}
+
+// AddContractID restricts the filter to the logs emitted by the contract, in addition to the ones already added.
+// Logs name the contract emitting them by its number, so a contract known only by the EVM address it was created
+// at, such as by CREATE2, must be resolved to its number first, for example with MirrorClient.GetContract. Such an
+// EVM address fails the next use of the filter.
+func (filter *ContractEventFilter) AddContractID(contractID ContractID) *ContractEventFilter {
+	if contractID.EvmAddress != nil && (len(contractID.EvmAddress) != 20 || !bytes.Equal(contractID.EvmAddress[:12], make([]byte, 12))) {
+		if filter.err == nil {
+			filter.err = fmt.Errorf("contract %s is named by an EVM address which logs don't carry, resolve it to its contract number first",
+				hex.EncodeToString(contractID.EvmAddress))
+		}
+		return filter
+	}
+
+	filter.contractIDs = append(filter.contractIDs, contractID)
+	return filter
+}
+
+// GetContractIDs returns the contracts the filter is restricted to
+func (filter *ContractEventFilter) GetContractIDs() []ContractID {
+	return filter.contractIDs
+}
+
+// AddEvent restricts the filter to the event, named as in the ABI or by its signature, in addition to the ones
+// already added. An event missing from the ABI fails the next use of the filter.
+func (filter *ContractEventFilter) AddEvent(name string) *ContractEventFilter {
+	event, err := filter.abi._Event(name)
+	if err != nil {
+		if filter.err == nil {
+			filter.err = err
+		}
+		return filter
+	}
+
+	if event.Anonymous {
+		if filter.err == nil {
+			filter.err = fmt.Errorf("anonymous event %s cannot be filtered by topic", event.Sig)
+		}
+		return filter
+	}
+
+	if filter.events == nil {
+		filter.events = make(map[string]abi.Event)
+	}
+	filter.events[event.Name] = event
+
+	return filter
+}
+
+// MightMatchBloom reports whether a bloom filter, such as the one of a ContractFunctionResult or of a single log, may
+// record a log the filter matches. It is a cheap check before looking at the logs themselves.
+func (filter *ContractEventFilter) MightMatchBloom(bloom []byte) bool {
+	if filter.err != nil {
+		return false
+	}
+
+	if len(filter.contractIDs) > 0 {
+		found := false
+		for _, contractID := range filter.contractIDs {
+			if _BloomContains(bloom, _ContractEventAddress(contractID)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, event := range filter._Events() {
+		if _BloomContains(bloom, event.ID.Bytes()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches reports whether the log was emitted by one of the contracts for one of the events of the filter
+func (filter *ContractEventFilter) Matches(log ContractLogInfo) bool {
+	_, ok := filter._Match(log)
+	return ok
+}
+
+// Filter decodes the logs the filter matches, skipping the others
+func (filter *ContractEventFilter) Filter(logs []ContractLogInfo) ([]ContractEvent, error) {
+	if filter.err != nil {
+		return nil, filter.err
+	}
+
+	events := make([]ContractEvent, 0)
+	for _, log := range logs {
+		name, ok := filter._Match(log)
+		if !ok {
+			continue
+		}
+
+		event, err := filter.abi._DecodeLog(name, filter.abi.abi.Events[name], log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// FilterResult decodes the logs of the contract call the filter matches, skipping the logs altogether when the
+// bloom filter of the result rules them out
+func (filter *ContractEventFilter) FilterResult(result ContractFunctionResult) ([]ContractEvent, error) {
+	if filter.err != nil {
+		return nil, filter.err
+	}
+
+	if !filter.MightMatchBloom(result.Bloom) {
+		return []ContractEvent{}, nil
+	}
+
+	return filter.Filter(result.LogInfo)
+}
+
+// FetchMirror decodes the logs the filter matches from the logs endpoints of the mirror node, querying the logs of
+// every contract of the filter or, without any, of all contracts. params are passed on to the mirror node, such as
+// "timestamp" to bound the time range; a filter on a single event also narrows the query by its topic.
+func (filter *ContractEventFilter) FetchMirror(ctx context.Context, client *Client, params url.Values) ([]ContractEvent, error) {
+	if filter.err != nil {
+		return nil, filter.err
+	}
+
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = append([]string{}, values...)
+	}
+	if events := filter._Events(); len(events) == 1 {
+		query.Set("topic0", "0x"+hex.EncodeToString(events[0].ID.Bytes()))
+	}
+
+	mirror := MirrorClientFromClient(client)
+
+	iterators := make([]*MirrorIterator[MirrorContractLog], 0)
+	if len(filter.contractIDs) == 0 {
+		iterators = append(iterators, mirror.ListAllContractLogs(ctx, query))
+	}
+	for _, contractID := range filter.contractIDs {
+		iterators = append(iterators, mirror.ListContractLogs(ctx, contractID.String(), query))
+	}
+
+	events := make([]ContractEvent, 0)
+	for _, iterator := range iterators {
+		for iterator.Next() {
+			mirrorLog := iterator.Item()
+			log, err := mirrorLog.ToContractLogInfo()
+			if err != nil {
+				return nil, err
+			}
+
+			name, ok := filter._Match(log)
+			if !ok {
+				continue
+			}
+
+			event, err := filter.abi._DecodeLog(name, filter.abi.abi.Events[name], log)
+			if err != nil {
+				return nil, err
+			}
+			if event.ConsensusTimestamp, err = mirrorLog.Timestamp.Time(); err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+
+		if err := iterator.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// _Match returns the name of the event the log was emitted for if the filter matches it
+func (filter *ContractEventFilter) _Match(log ContractLogInfo) (string, bool) {
+	if filter.err != nil || len(log.Topics) == 0 {
+		return "", false
+	}
+
+	if len(filter.contractIDs) > 0 {
+		// Filters may name a contract by its EVM address while logs carry its number, so compare addresses
+		address := _ContractEventAddress(log.ContractID)
+		found := false
+		for _, contractID := range filter.contractIDs {
+			if bytes.Equal(_ContractEventAddress(contractID), address) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", false
+		}
+	}
+
+	for _, event := range filter._Events() {
+		if !log.BloomContains(event.ID.Bytes()) {
+			continue
+		}
+		if bytes.Equal(log.Topics[0], event.ID.Bytes()) {
+			return event.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// _Events returns the events of the filter, or every event of the ABI that has a topic
+func (filter *ContractEventFilter) _Events() []abi.Event {
+	events := make([]abi.Event, 0)
+	if len(filter.events) > 0 {
+		for _, event := range filter.events {
+			events = append(events, event)
+		}
+		return events
+	}
+
+	for _, event := range filter.abi.abi.Events {
+		if !event.Anonymous {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// _ContractEventAddress returns the EVM address a contract emits its logs from
+func _ContractEventAddress(contractID ContractID) []byte {
+	if contractID.EvmAddress != nil {
+		return contractID.EvmAddress
+	}
+
+	address, _ := hex.DecodeString(contractID.ToSolidityAddress())
+	return address
+}
+
+// ToContractLogInfo converts the log to the ContractLogInfo of a ContractFunctionResult
+func (log MirrorContractLog) ToContractLogInfo() (ContractLogInfo, error) {
+	contractID, err := ContractIDFromString(log.ContractID)
+	if err != nil {
+		return ContractLogInfo{}, err
+	}
+
+	bloom, err := _MirrorHexDecode(log.Bloom)
+	if err != nil {
+		return ContractLogInfo{}, errors.Wrap(err, "bloom")
+	}
+
+	data, err := _MirrorHexDecode(log.Data)
+	if err != nil {
+		return ContractLogInfo{}, errors.Wrap(err, "data")
+	}
+
+	topics := make([][]byte, len(log.Topics))
+	for i, topic := range log.Topics {
+		if topics[i], err = _MirrorHexDecode(topic); err != nil {
+			return ContractLogInfo{}, errors.Wrap(err, "topic")
+		}
+	}
+
+	return ContractLogInfo{
+		ContractID: contractID,
+		Bloom:      bloom,
+		Topics:     topics,
+		Data:       data,
+	}, nil
+}
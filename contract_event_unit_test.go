@@ -0,0 +1,228 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// _TestBloom builds the Ethereum bloom filter recording the values
+func _TestBloom(values ...[]byte) []byte {
+	bloom := make([]byte, 256)
+	for _, value := range values {
+		hash := crypto.Keccak256(value)
+		for i := 0; i < 6; i += 2 {
+			bit := (uint(hash[i])<<8 | uint(hash[i+1])) & 2047
+			bloom[255-bit/8] |= 1 << (bit % 8)
+		}
+	}
+
+	return bloom
+}
+
+func _TestTransferLog(t *testing.T, contractID ContractID, value int64) ContractLogInfo {
+	event := _TestContractABI(t).GetABI().Events["Transfer"]
+
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(value))
+	require.NoError(t, err)
+
+	address, err := hex.DecodeString(contractID.ToSolidityAddress())
+	require.NoError(t, err)
+
+	from := common.LeftPadBytes([]byte{0x04, 0xd2}, 32)
+	memo := crypto.Keccak256([]byte("memo"))
+
+	return ContractLogInfo{
+		ContractID: contractID,
+		Bloom:      _TestBloom(address, event.ID.Bytes(), from, memo),
+		Topics:     [][]byte{event.ID.Bytes(), from, memo},
+		Data:       data,
+	}
+}
+
+func TestUnitContractABIDecodeLog(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+	log := _TestTransferLog(t, ContractID{Contract: 7}, 42)
+
+	event, err := contractABI.DecodeLog(log)
+	require.NoError(t, err)
+	require.Equal(t, "Transfer", event.Name)
+	require.Equal(t, "Transfer(address,string,uint256)", event.Signature)
+	require.Equal(t, ContractID{Contract: 7}, event.ContractID)
+	require.Equal(t, common.HexToAddress("0x04d2"), event.Args["from"])
+	require.Equal(t, common.BytesToHash(crypto.Keccak256([]byte("memo"))), event.Args["memo"])
+	require.Equal(t, big.NewInt(42), event.Values[2])
+
+	log.Topics[0] = make([]byte, 32)
+	_, err = contractABI.DecodeLog(log)
+	require.ErrorContains(t, err, "no event with topic")
+
+	_, err = contractABI.DecodeLog(ContractLogInfo{})
+	require.ErrorContains(t, err, "no topics")
+}
+
+func TestUnitContractLogInfoBloomContains(t *testing.T) {
+	t.Parallel()
+
+	log := _TestTransferLog(t, ContractID{Contract: 7}, 42)
+	require.True(t, log.BloomContains(log.Topics[0]))
+	require.True(t, log.BloomContains(log.Topics[1]))
+	require.False(t, log.BloomContains([]byte("something else")))
+
+	log.Bloom = nil
+	require.True(t, log.BloomContains([]byte("something else")))
+}
+
+func TestUnitContractEventFilter(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABI(t)
+	matching := _TestTransferLog(t, ContractID{Contract: 7}, 1)
+	otherContract := _TestTransferLog(t, ContractID{Contract: 8}, 2)
+	otherEvent := _TestTransferLog(t, ContractID{Contract: 7}, 3)
+	otherEvent.Topics[0] = crypto.Keccak256([]byte("Approval(address,address,uint256)"))
+
+	filter := NewContractEventFilter(contractABI).
+		AddContractID(ContractID{Contract: 7}).
+		AddEvent("Transfer")
+
+	require.True(t, filter.Matches(matching))
+	require.False(t, filter.Matches(otherContract))
+	require.False(t, filter.Matches(otherEvent))
+
+	events, err := filter.Filter([]ContractLogInfo{otherContract, matching, otherEvent})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, big.NewInt(1), events[0].Args["value"])
+
+	// the bloom filter rules out logs before their topics are compared
+	unrecorded := _TestTransferLog(t, ContractID{Contract: 7}, 4)
+	unrecorded.Bloom = _TestBloom([]byte("unrelated"))
+	require.False(t, filter.Matches(unrecorded))
+
+	result := ContractFunctionResult{Bloom: _TestBloom([]byte("unrelated")), LogInfo: []ContractLogInfo{matching}}
+	events, err = filter.FilterResult(result)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	result.Bloom = matching.Bloom
+	events, err = filter.FilterResult(result)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	events, err = NewContractEventFilter(contractABI).Filter([]ContractLogInfo{otherContract, otherEvent})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, ContractID{Contract: 8}, events[0].ContractID)
+
+	_, err = NewContractEventFilter(contractABI).AddEvent("Approval").Filter([]ContractLogInfo{matching})
+	require.ErrorContains(t, err, "no event Approval")
+}
+
+func TestUnitContractEventFilterEvmAddress(t *testing.T) {
+	t.Parallel()
+
+	contractID, err := ContractIDFromEvmAddress(0, 0, ContractID{Contract: 7}.ToSolidityAddress())
+	require.NoError(t, err)
+
+	filter := NewContractEventFilter(_TestContractABI(t)).
+		AddContractID(contractID).
+		AddEvent("Transfer")
+
+	require.True(t, filter.Matches(_TestTransferLog(t, ContractID{Contract: 7}, 1)))
+	require.False(t, filter.Matches(_TestTransferLog(t, ContractID{Contract: 8}, 1)))
+
+	events, err := filter.Filter([]ContractLogInfo{_TestTransferLog(t, ContractID{Contract: 7}, 1)})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
+
+func TestUnitContractEventFilterCreate2Address(t *testing.T) {
+	t.Parallel()
+
+	contractID, err := ContractIDFromEvmAddress(0, 0, "742d35cc6634c0532925a3b844bc454e4438f44e")
+	require.NoError(t, err)
+
+	filter := NewContractEventFilter(_TestContractABI(t)).AddContractID(contractID)
+	require.Empty(t, filter.GetContractIDs())
+	require.False(t, filter.Matches(_TestTransferLog(t, ContractID{Contract: 7}, 1)))
+	require.False(t, filter.MightMatchBloom(_TestTransferLog(t, ContractID{Contract: 7}, 1).Bloom))
+
+	_, err = filter.Filter([]ContractLogInfo{_TestTransferLog(t, ContractID{Contract: 7}, 1)})
+	require.ErrorContains(t, err, "resolve it to its contract number")
+
+	_, err = filter.FilterResult(ContractFunctionResult{})
+	require.ErrorContains(t, err, "resolve it to its contract number")
+
+	_, err = filter.FetchMirror(context.Background(), nil, nil)
+	require.ErrorContains(t, err, "resolve it to its contract number")
+}
+
+func TestUnitContractEventFilterFetchMirror(t *testing.T) {
+	t.Parallel()
+
+	matching := _TestTransferLog(t, ContractID{Contract: 7}, 42)
+	topics := make([]string, len(matching.Topics))
+	for i, topic := range matching.Topics {
+		topics[i] = fmt.Sprintf("%q", "0x"+hex.EncodeToString(topic))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/contracts/0.0.7/results/logs", r.URL.Path)
+		require.Equal(t, "0x"+hex.EncodeToString(matching.Topics[0]), r.URL.Query().Get("topic0"))
+		require.Equal(t, "gte:1700000000", r.URL.Query().Get("timestamp"))
+		_, _ = fmt.Fprintf(w, `{"logs":[{"contract_id":"0.0.7","bloom":"0x%s","data":"0x%s","topics":[%s,%s,%s],"timestamp":"1700000001.000000002"},`+
+			`{"contract_id":"0.0.7","bloom":"0x","data":"0x","topics":["0x01"],"timestamp":"1700000002.000000000"}],"links":{"next":null}}`,
+			hex.EncodeToString(matching.Bloom), hex.EncodeToString(matching.Data), topics[0], topics[1], topics[2])
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	events, err := NewContractEventFilter(_TestContractABI(t)).
+		AddContractID(ContractID{Contract: 7}).
+		AddEvent("Transfer(address,string,uint256)").
+		FetchMirror(context.Background(), client, url.Values{"timestamp": {"gte:1700000000"}})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, big.NewInt(42), events[0].Args["value"])
+	require.Equal(t, time.Unix(1700000001, 2), events[0].ConsensusTimestamp)
+	require.Equal(t, matching, events[0].Log)
+}
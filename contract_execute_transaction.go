@@ -39,6 +39,8 @@ type ContractExecuteTransaction struct {
 	gas        int64
 	amount     int64
 	parameters []byte
+
+	gasEstimateMargin *uint64
 }
 
 // NewContractExecuteTransaction creates a ContractExecuteTransaction transaction which can be
@@ -90,6 +92,23 @@ func (tx *ContractExecuteTransaction) GetGas() uint64 {
 	return uint64(tx.gas)
 }
 
+// SetGasFromEstimate makes Execute and FreezeWith estimate the gas of the call with the client's
+// mirror node and set the gas to the estimate plus marginPercent percent, replacing any gas set.
+func (tx *ContractExecuteTransaction) SetGasFromEstimate(marginPercent uint64) *ContractExecuteTransaction {
+	tx._RequireNotFrozen()
+	tx.gasEstimateMargin = &marginPercent
+	return tx
+}
+
+// GetGasFromEstimate returns the safety margin added to the estimated gas and whether gas is estimated
+func (tx *ContractExecuteTransaction) GetGasFromEstimate() (uint64, bool) {
+	if tx.gasEstimateMargin == nil {
+		return 0, false
+	}
+
+	return *tx.gasEstimateMargin, true
+}
+
 // SetPayableAmount sets the amount of Hbar sent (the function must be payable if this is nonzero)
 func (tx *ContractExecuteTransaction) SetPayableAmount(amount Hbar) *ContractExecuteTransaction {
 	tx._RequireNotFrozen()
@@ -173,6 +192,9 @@ func (tx *ContractExecuteTransaction) Freeze() (*ContractExecuteTransaction, err
 }
 
 func (tx *ContractExecuteTransaction) FreezeWith(client *Client) (*ContractExecuteTransaction, error) {
+	if err := tx._ApplyGasEstimate(client); err != nil {
+		return tx, err
+	}
 	_, err := tx.Transaction.freezeWith(client, tx)
 	return tx, err
 }
@@ -254,13 +276,51 @@ func (tx *ContractExecuteTransaction) SetLogLevel(level LogLevel) *ContractExecu
 }
 
 func (tx *ContractExecuteTransaction) Execute(client *Client) (TransactionResponse, error) {
+	if err := tx._ApplyGasEstimate(client); err != nil {
+		return TransactionResponse{}, err
+	}
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the ContractExecuteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *ContractExecuteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed ContractExecuteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *ContractExecuteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *ContractExecuteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
 
+func (tx *ContractExecuteTransaction) _ApplyGasEstimate(client *Client) error {
+	if tx.gasEstimateMargin == nil || tx.IsFrozen() {
+		return nil
+	}
+
+	call := MirrorContractCall{
+		Data:  _MirrorHex(tx.parameters),
+		To:    _MirrorContractAddress(tx.GetContractID()),
+		Value: tx.amount,
+	}
+	if transactionID := tx.GetTransactionID(); transactionID.AccountID != nil {
+		call.From = "0x" + transactionID.AccountID.ToSolidityAddress()
+	}
+
+	gas, err := _MirrorEstimateGas(client, call, *tx.gasEstimateMargin)
+	if err != nil {
+		return err
+	}
+	tx.gas = int64(gas)
+
+	return nil
+}
+
 // ----------- Overridden functions ----------------
 
 func (tx *ContractExecuteTransaction) getName() string {
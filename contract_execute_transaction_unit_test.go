@@ -25,6 +25,9 @@ package hedera
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -308,3 +311,38 @@ func TestUnitContractExecuteTransactionCoverage(t *testing.T) {
 		b.AddSignature(newKey.PublicKey(), sig)
 	}
 }
+
+func TestUnitContractExecuteTransactionGasFromEstimate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var call MirrorContractCall
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&call))
+		require.True(t, call.Estimate)
+		require.Equal(t, int64(5), call.Value)
+		require.Equal(t, "0x"+testTransactionID.AccountID.ToSolidityAddress(), call.From)
+
+		_, _ = w.Write([]byte(`{"result":"0x186a0"}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	transaction, err := NewContractExecuteTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		SetContractID(ContractID{Contract: 5}).
+		SetPayableAmount(HbarFromTinybar(5)).
+		SetFunction("setNumber", NewContractFunctionParameters().AddUint64(7)).
+		SetGas(1).
+		SetGasFromEstimate(20).
+		FreezeWith(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(120000), transaction.GetGas())
+
+	margin, ok := transaction.GetGasFromEstimate()
+	require.True(t, ok)
+	require.Equal(t, uint64(20), margin)
+}
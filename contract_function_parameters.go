@@ -1825,6 +1825,89 @@ func (contract *ContractFunctionParameters) AddStringArray(value []string) *Cont
 	return contract
 }
 
+// AddTuple adds a tuple or struct parameter to the function call, with the parameters added to tuple as its
+// components in order. Tuples may be nested, and the selector gains the components, such as (address,uint256).
+func (contract *ContractFunctionParameters) AddTuple(tuple *ContractFunctionParameters) *ContractFunctionParameters {
+	contract._AddComposite(tuple)
+	contract.function.AddTuple(&tuple.function)
+	return contract
+}
+
+// AddFixedArray adds a fixed-size array parameter to the function call, with the parameters added to elements as its
+// elements, such as uint256[3] for three AddUint256 calls. The elements must all be of the same type and may
+// themselves be arrays or tuples.
+func (contract *ContractFunctionParameters) AddFixedArray(elements *ContractFunctionParameters) (*ContractFunctionParameters, error) {
+	element, err := elements._ElementSelector()
+	if err != nil {
+		return contract, err
+	}
+
+	contract._AddComposite(elements)
+	contract.function.AddFixedArray(&element, uint64(len(elements.function.paramTypes)))
+	return contract, nil
+}
+
+// AddNestedArray adds a dynamically-sized array parameter to the function call, with the parameters added to elements
+// as its elements, such as uint256[][] for AddUint256Array calls or (address,uint256)[] for AddTuple calls. The
+// elements must all be of the same type, and at least one is needed to tell the type.
+func (contract *ContractFunctionParameters) AddNestedArray(elements *ContractFunctionParameters) (*ContractFunctionParameters, error) {
+	element, err := elements._ElementSelector()
+	if err != nil {
+		return contract, err
+	}
+
+	argument := _NewArgument()
+	argument.dynamic = true
+	binary.BigEndian.PutUint64(argument.value[24:32], uint64(len(elements.function.paramTypes)))
+	argument.value = append(argument.value, elements._Build(nil)...)
+
+	contract.function.AddNestedArray(&element)
+	contract.arguments = append(contract.arguments, argument)
+	return contract, nil
+}
+
+// _AddComposite adds the encoding of a tuple or fixed-size array. It is inlined in the head when every component is
+// static, one 32 byte word per component, and otherwise placed in the tail like any dynamic parameter.
+func (contract *ContractFunctionParameters) _AddComposite(components *ContractFunctionParameters) {
+	for _, argument := range components.arguments {
+		if argument.dynamic {
+			contract.arguments = append(contract.arguments, Argument{
+				value:   components._Build(nil),
+				dynamic: true,
+			})
+			return
+		}
+	}
+
+	contract.arguments = append(contract.arguments, components.arguments...)
+}
+
+// _ElementSelector returns the selector of the single type all of the parameters are
+func (contract *ContractFunctionParameters) _ElementSelector() (ContractFunctionSelector, error) {
+	element := NewContractFunctionSelector("")
+	if len(contract.function.paramTypes) == 0 {
+		return element, errors.New("array needs at least one element to tell its type")
+	}
+
+	first := contract.function.paramTypes[0]
+	for _, ty := range contract.function.paramTypes[1:] {
+		if ty != first {
+			return element, fmt.Errorf("array elements are of different types %s and %s", _SolidityString(first), _SolidityString(ty))
+		}
+	}
+
+	element._AddParam(first)
+	return element, nil
+}
+
+func _SolidityString(ty _Solidity) string {
+	if ty.array {
+		return string(ty.ty) + "[]"
+	}
+
+	return string(ty.ty)
+}
+
 func (contract *ContractFunctionParameters) _Build(functionName *string) []byte {
 	length := uint64(0)
 
@@ -0,0 +1,139 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTupleContractABI = `[
+	{"type":"function","name":"submit","inputs":[{"name":"orders","type":"tuple[]","components":[{"name":"maker","type":"address"},{"name":"amount","type":"uint256"}]}]},
+	{"type":"function","name":"register","inputs":[{"name":"entry","type":"tuple","components":[{"name":"name","type":"string"},{"name":"ids","type":"uint64[]"},{"name":"owner","type":"tuple","components":[{"name":"account","type":"address"},{"name":"active","type":"bool"}]}]},{"name":"flag","type":"uint8"}]},
+	{"type":"function","name":"arrays","inputs":[{"name":"fixed","type":"uint64[3]"},{"name":"nested","type":"uint64[][]"},{"name":"names","type":"string[2]"},{"name":"last","type":"bool"}]}
+]`
+
+func TestUnitContractFunctionParametersTupleArray(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := ContractABIFromJSON([]byte(testTupleContractABI))
+	require.NoError(t, err)
+
+	expected, err := contractABI.Pack("submit", []interface{}{
+		[]interface{}{"0x00000000000000000000000000000000000004d2", 5},
+		[]interface{}{"0x00000000000000000000000000000000000004d3", 6},
+	})
+	require.NoError(t, err)
+
+	orders := NewContractFunctionParameters()
+	for i, address := range []string{"00000000000000000000000000000000000004d2", "00000000000000000000000000000000000004d3"} {
+		order, err := NewContractFunctionParameters().AddAddress(address)
+		require.NoError(t, err)
+		orders.AddTuple(order.AddUint256BigInt(big.NewInt(int64(5 + i))))
+	}
+
+	params, err := NewContractFunctionParameters().AddNestedArray(orders)
+	require.NoError(t, err)
+
+	name := "submit"
+	require.Equal(t, expected, params._Build(&name))
+	require.Equal(t, "submit((address,uint256)[])", params.function.String())
+}
+
+func TestUnitContractFunctionParametersNestedTuple(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := ContractABIFromJSON([]byte(testTupleContractABI))
+	require.NoError(t, err)
+
+	expected, err := contractABI.Pack("register", []interface{}{
+		"alice", []uint64{1, 2}, []interface{}{"0x00000000000000000000000000000000000004d2", true},
+	}, 7)
+	require.NoError(t, err)
+
+	owner, err := NewContractFunctionParameters().AddAddress("00000000000000000000000000000000000004d2")
+	require.NoError(t, err)
+
+	entry := NewContractFunctionParameters().
+		AddString("alice").
+		AddUint64Array([]uint64{1, 2}).
+		AddTuple(owner.AddBool(true))
+
+	params := NewContractFunctionParameters().AddTuple(entry).AddUint8(7)
+
+	name := "register"
+	require.Equal(t, expected, params._Build(&name))
+	require.Equal(t, "register((string,uint64[],(address,bool)),uint8)", params.function.String())
+}
+
+func TestUnitContractFunctionParametersFixedAndNestedArrays(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := ContractABIFromJSON([]byte(testTupleContractABI))
+	require.NoError(t, err)
+
+	expected, err := contractABI.Pack("arrays", []uint64{1, 2, 3}, [][]uint64{{4}, {5, 6}}, []string{"a", "b"}, true)
+	require.NoError(t, err)
+
+	fixed, err := NewContractFunctionParameters().AddFixedArray(NewContractFunctionParameters().AddUint64(1).AddUint64(2).AddUint64(3))
+	require.NoError(t, err)
+
+	nested, err := fixed.AddNestedArray(NewContractFunctionParameters().AddUint64Array([]uint64{4}).AddUint64Array([]uint64{5, 6}))
+	require.NoError(t, err)
+
+	params, err := nested.AddFixedArray(NewContractFunctionParameters().AddString("a").AddString("b"))
+	require.NoError(t, err)
+	params.AddBool(true)
+
+	name := "arrays"
+	require.Equal(t, expected, params._Build(&name))
+	require.Equal(t, "arrays(uint64[3],uint64[][],string[2],bool)", params.function.String())
+}
+
+func TestUnitContractFunctionParametersArrayErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewContractFunctionParameters().AddFixedArray(NewContractFunctionParameters().AddUint64(1).AddString("a"))
+	require.ErrorContains(t, err, "different types uint64 and string")
+
+	_, err = NewContractFunctionParameters().AddNestedArray(NewContractFunctionParameters())
+	require.ErrorContains(t, err, "at least one element")
+}
+
+func TestUnitContractFunctionSelectorComposites(t *testing.T) {
+	t.Parallel()
+
+	components := NewContractFunctionSelector("")
+	components.AddAddress().AddUint256()
+
+	element := NewContractFunctionSelector("")
+	element.AddUint256Array()
+
+	selector := NewContractFunctionSelector("f")
+	selector.AddTuple(&components).AddTupleArray(&components).AddFixedArray(&element, 2).AddNestedArray(&element)
+
+	require.Equal(t, "f((address,uint256),(address,uint256)[],uint256[][2],uint256[][])", selector.String())
+}
@@ -428,6 +428,16 @@ func (result ContractFunctionResult) AsBytes() []byte {
 	return result.ContractCallResult
 }
 
+// GetRevertError decodes the revert of the call, or returns nil if the call did not fail. Custom errors are left
+// undecoded with their raw data, which ContractABI.DecodeRevert decodes.
+func (result ContractFunctionResult) GetRevertError() *ContractRevertError {
+	if result.ErrorMessage == "" {
+		return nil
+	}
+
+	return _ContractRevertFromMessage(result.ErrorMessage, result.ContractCallResult)
+}
+
 // GetResult parses the result of a contract call based on the given types string and returns the result as an interface.
 // The "types" string should specify the Ethereum Solidity type of the contract call output.
 // This includes types like "uint256", "address", "bool", "string", "string[]", etc.
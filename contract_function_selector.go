@@ -21,6 +21,8 @@ package hedera
  */
 
 import (
+	"fmt"
+
 	"golang.org/x/crypto/sha3"
 )
 
@@ -807,6 +809,39 @@ func (selector *ContractFunctionSelector) AddBytes32Array() *ContractFunctionSel
 	})
 }
 
+// AddTuple adds a tuple parameter to the selector, with the parameters of components as its components, such as
+// (address,uint256).
+func (selector *ContractFunctionSelector) AddTuple(components *ContractFunctionSelector) *ContractFunctionSelector {
+	return selector._AddParam(_Solidity{
+		ty: argument("(" + components.params + ")"),
+	})
+}
+
+// AddTupleArray adds an array of tuples parameter to the selector, such as (address,uint256)[].
+func (selector *ContractFunctionSelector) AddTupleArray(components *ContractFunctionSelector) *ContractFunctionSelector {
+	return selector._AddParam(_Solidity{
+		ty:    argument("(" + components.params + ")"),
+		array: true,
+	})
+}
+
+// AddFixedArray adds a fixed-size array parameter to the selector, such as uint256[3]. element holds the single
+// parameter the elements are.
+func (selector *ContractFunctionSelector) AddFixedArray(element *ContractFunctionSelector, length uint64) *ContractFunctionSelector {
+	return selector._AddParam(_Solidity{
+		ty: argument(fmt.Sprintf("%s[%d]", element.params, length)),
+	})
+}
+
+// AddNestedArray adds a dynamically-sized array parameter to the selector whose elements may themselves be arrays or
+// tuples, such as uint256[3][] or (address,uint256)[][]. element holds the single parameter the elements are.
+func (selector *ContractFunctionSelector) AddNestedArray(element *ContractFunctionSelector) *ContractFunctionSelector {
+	return selector._AddParam(_Solidity{
+		ty:    argument(element.params),
+		array: true,
+	})
+}
+
 // String returns the string representation of the selector.
 func (selector *ContractFunctionSelector) String() string {
 	function := ""
@@ -28,6 +28,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/pkg/errors"
 	protobuf "google.golang.org/protobuf/proto"
@@ -171,6 +173,27 @@ func (id ContractID) ToSolidityAddress() string {
 	return _IdToSolidityAddress(id.Shard, id.Realm, id.Contract)
 }
 
+// PredictCreateAddress returns the ContractID, by EVM address, of the contract this contract deploys
+// with the CREATE opcode when its contract nonce is the given nonce
+func (id ContractID) PredictCreateAddress(nonce uint64) ContractID {
+	address := crypto.CreateAddress(common.BytesToAddress(_ContractEvmAddress(id)), nonce)
+
+	return ContractID{Shard: id.Shard, Realm: id.Realm, EvmAddress: address.Bytes()}
+}
+
+// PredictCreate2Address returns the ContractID, by EVM address, of the contract this contract deploys
+// with the CREATE2 opcode, given the 32 byte salt and the init code, which is the creation bytecode
+// followed by the encoded constructor parameters
+func (id ContractID) PredictCreate2Address(salt []byte, initCode []byte) (ContractID, error) {
+	if len(salt) != 32 {
+		return ContractID{}, errors.Errorf("CREATE2 salt must be 32 bytes, got %d", len(salt))
+	}
+
+	address := crypto.CreateAddress2(common.BytesToAddress(_ContractEvmAddress(id)), common.BytesToHash(salt), crypto.Keccak256(initCode))
+
+	return ContractID{Shard: id.Shard, Realm: id.Realm, EvmAddress: address.Bytes()}, nil
+}
+
 // PopulateContract gets the actual `Contract` field of the `ContractId` from the Mirror Node.
 // Should be used after generating `ContractId.FromEvmAddress()` because it sets the `Contract` field to `0`
 // automatically since there is no connection between the `Contract` and the `evmAddress`
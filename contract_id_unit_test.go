@@ -162,3 +162,38 @@ func TestUnitContractIDPopulateFailWithNoMirror(t *testing.T) {
 	err = evmAddressAccountID.PopulateContract(client)
 	require.Error(t, err)
 }
+
+func TestUnitContractIDPredictCreateAddress(t *testing.T) {
+	t.Parallel()
+
+	deployer, err := ContractIDFromEvmAddress(0, 0, "6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	require.NoError(t, err)
+
+	require.Equal(t, "cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d", hex.EncodeToString(deployer.PredictCreateAddress(0).EvmAddress))
+	require.Equal(t, "343c43a37d37dff08ae8c4a11544c718abb4fcf8", hex.EncodeToString(deployer.PredictCreateAddress(1).EvmAddress))
+
+	predicted := ContractID{Shard: 1, Realm: 2, Contract: 3}.PredictCreateAddress(0)
+	require.Equal(t, uint64(1), predicted.Shard)
+	require.Equal(t, uint64(2), predicted.Realm)
+	require.Len(t, predicted.EvmAddress, 20)
+}
+
+func TestUnitContractIDPredictCreate2Address(t *testing.T) {
+	t.Parallel()
+
+	// test vectors of EIP-1014
+	predicted, err := ContractID{}.PredictCreate2Address(make([]byte, 32), []byte{0x00})
+	require.NoError(t, err)
+	require.Equal(t, "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38", hex.EncodeToString(predicted.EvmAddress))
+
+	deployer, err := ContractIDFromEvmAddress(0, 0, "deadbeef00000000000000000000000000000000")
+	require.NoError(t, err)
+	salt, err := hex.DecodeString("000000000000000000000000feed000000000000000000000000000000000000")
+	require.NoError(t, err)
+	predicted, err = deployer.PredictCreate2Address(salt, []byte{0x00})
+	require.NoError(t, err)
+	require.Equal(t, "d04116cdd17bebe565eb2422f2497e06cc1c9833", hex.EncodeToString(predicted.EvmAddress))
+
+	_, err = deployer.PredictCreate2Address([]byte{1}, nil)
+	require.Error(t, err)
+}
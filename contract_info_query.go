@@ -70,6 +70,8 @@ func (q *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
+	// the answer reflects consensus state no newer than the moment the query was sent
+	requested := time.Now()
 	resp, err := q.Query.execute(client, q)
 
 	if err != nil {
@@ -81,7 +83,7 @@ func (q *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 		return ContractInfo{}, err
 	}
 
-	err = fetchContractInfoTokenRelationships(fetchMirrorNodeUrlFromClient(client), q.contractID.String(), &info)
+	err = fetchContractInfoTokenRelationships(MirrorClientFromClient(client), q.contractID.String(), requested, &info)
 	if err != nil {
 		return info, err
 	}
@@ -96,11 +98,12 @@ relationships and populates the appropriate field in ContractInfo object
 IMPORTANT: This function will fetch the state of the data in the Mirror Node at the moment of its execution. It
 is important to note that the Mirror Node currently needs 2-3 seconds to be updated with the latest data from the
 consensus nodes. So if data related to token relationships is changed and a proper timeout is not introduced the
-user would not get the up to date state of token relationships. This note is ONLY for token relationship data as it
+user would not get the up to date state of token relationships. When mirror polling is enabled, the mirror node is
+first given time to ingest consensus up to the given timestamp. This note is ONLY for token relationship data as it
 is queried from the MirrorNode. Other query information arrives at the time of consensus response.
 */
-func fetchContractInfoTokenRelationships(network string, id string, info *ContractInfo) error {
-	response, err := tokenRelationshipMirrorNodeQuery(network, id)
+func fetchContractInfoTokenRelationships(mirror *MirrorClient, id string, consensusTimestamp time.Time, info *ContractInfo) error {
+	response, err := tokenRelationshipMirrorNodeQuery(mirror, id, consensusTimestamp)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,215 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ContractRevertKind is how the revert data of a failed contract call decoded
+type ContractRevertKind uint32
+
+const (
+	// ContractRevertUnknown is a revert without data, or with data that is not ABI encoded
+	ContractRevertUnknown ContractRevertKind = iota
+	// ContractRevertReason is a revert with an Error(string) reason, as raised by require and revert
+	ContractRevertReason
+	// ContractRevertPanic is a Panic(uint256), as raised by failed asserts and arithmetic checks
+	ContractRevertPanic
+	// ContractRevertCustom is a custom error, only named when decoded with the ContractABI declaring it
+	ContractRevertCustom
+)
+
+// String returns a string representation of the ContractRevertKind
+func (kind ContractRevertKind) String() string {
+	switch kind {
+	case ContractRevertUnknown:
+		return "UNKNOWN"
+	case ContractRevertReason:
+		return "REASON"
+	case ContractRevertPanic:
+		return "PANIC"
+	case ContractRevertCustom:
+		return "CUSTOM"
+	}
+
+	return fmt.Sprintf("ContractRevertKind(%d)", uint32(kind))
+}
+
+var (
+	_ContractRevertReasonSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	_ContractRevertPanicSelector  = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// _ContractPanicReasons names the panic codes of the Solidity compiler
+var _ContractPanicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized internal function",
+}
+
+// ContractRevertError is the decoded revert of a failed contract call. It is returned wrapped in the
+// ErrHederaReceiptStatus, ErrHederaRecordStatus or ErrHederaPreCheckStatus of a reverted call, and in the
+// ErrMirrorNodeResponse of a reverted ContractCallQuery.ExecuteViaMirror, so errors.As finds it.
+type ContractRevertError struct {
+	Kind ContractRevertKind
+	// Reason is the Error(string) reason, or the error message of the node when it reported no ABI encoded data
+	Reason string
+	// PanicCode is the code of a Panic(uint256)
+	PanicCode uint64
+	// Name is the name of the custom error, empty unless the ContractABI declaring it decoded the revert
+	Name string
+	// Signature is the signature of the custom error, such as "InsufficientBalance(uint256,uint256)"
+	Signature string
+	// Args are the decoded arguments of the custom error
+	Args []interface{}
+	// Data is the raw revert data, starting with the selector of the error
+	Data []byte
+}
+
+// DecodeContractRevert decodes the revert data of a contract call. Custom errors are left undecoded with their raw
+// data, which ContractABI.DecodeRevert decodes.
+func DecodeContractRevert(data []byte) *ContractRevertError {
+	revert := &ContractRevertError{Kind: ContractRevertUnknown, Data: data}
+	if len(data) < 4 {
+		return revert
+	}
+
+	switch {
+	case bytes.Equal(data[:4], _ContractRevertReasonSelector):
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			revert.Kind = ContractRevertReason
+			revert.Reason = reason
+		}
+	case bytes.Equal(data[:4], _ContractRevertPanicSelector):
+		if len(data) == 36 {
+			code := new(big.Int).SetBytes(data[4:])
+			if code.IsUint64() {
+				revert.Kind = ContractRevertPanic
+				revert.PanicCode = code.Uint64()
+			}
+		}
+	default:
+		revert.Kind = ContractRevertCustom
+	}
+
+	return revert
+}
+
+// DecodeRevert decodes the revert data of a call to the contract, including the custom errors of the ABI
+func (contractABI *ContractABI) DecodeRevert(data []byte) *ContractRevertError {
+	revert := DecodeContractRevert(data)
+	if revert.Kind != ContractRevertCustom {
+		return revert
+	}
+
+	for name, abiError := range contractABI.abi.Errors {
+		if !bytes.Equal(data[:4], abiError.ID[:4]) {
+			continue
+		}
+
+		args, err := abiError.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+
+		revert.Name = name
+		revert.Signature = abiError.Sig
+		revert.Args = args
+		break
+	}
+
+	return revert
+}
+
+// _ContractRevertFromMessage decodes a revert from the error message of a ContractFunctionResult, which is the hex
+// encoded revert data when there is any, falling back to the result of the call
+func _ContractRevertFromMessage(message string, callResult []byte) *ContractRevertError {
+	if strings.HasPrefix(message, "0x") {
+		if data, err := hex.DecodeString(message[2:]); err == nil {
+			return DecodeContractRevert(data)
+		}
+	}
+
+	revert := DecodeContractRevert(callResult)
+	if revert.Kind == ContractRevertUnknown {
+		revert.Reason = message
+	}
+
+	return revert
+}
+
+// GetPanicReason returns what the panic code of a Panic(uint256) means
+func (e ContractRevertError) GetPanicReason() string {
+	if reason, ok := _ContractPanicReasons[e.PanicCode]; ok {
+		return reason
+	}
+
+	return "unknown panic code"
+}
+
+// GetSelector returns the hex encoded selector of the error, empty when the revert has no data
+func (e ContractRevertError) GetSelector() string {
+	if len(e.Data) < 4 {
+		return ""
+	}
+
+	return hex.EncodeToString(e.Data[:4])
+}
+
+// Error() implements the Error interface
+func (e ContractRevertError) Error() string {
+	switch e.Kind {
+	case ContractRevertReason:
+		return fmt.Sprintf("execution reverted: %s", e.Reason)
+	case ContractRevertPanic:
+		return fmt.Sprintf("execution reverted: panic 0x%02x (%s)", e.PanicCode, e.GetPanicReason())
+	case ContractRevertCustom:
+		if e.Name == "" {
+			return fmt.Sprintf("execution reverted: custom error 0x%s", e.GetSelector())
+		}
+
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = fmt.Sprintf("%v", arg)
+		}
+		return fmt.Sprintf("execution reverted: %s(%s)", e.Name, strings.Join(args, ", "))
+	}
+
+	if e.Reason != "" {
+		return fmt.Sprintf("execution reverted: %s", e.Reason)
+	}
+
+	return "execution reverted"
+}
@@ -0,0 +1,188 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestRevertReason(t *testing.T, reason string) []byte {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+
+	data, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	require.NoError(t, err)
+	return append(append([]byte{}, _ContractRevertReasonSelector...), data...)
+}
+
+func _TestContractABIWithErrors(t *testing.T) *ContractABI {
+	contractABI, err := ContractABIFromJSON([]byte(`[
+		{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+	]`))
+	require.NoError(t, err)
+	return contractABI
+}
+
+func TestUnitDecodeContractRevert(t *testing.T) {
+	t.Parallel()
+
+	revert := DecodeContractRevert(_TestRevertReason(t, "not the owner"))
+	require.Equal(t, ContractRevertReason, revert.Kind)
+	require.Equal(t, "not the owner", revert.Reason)
+	require.Equal(t, "execution reverted: not the owner", revert.Error())
+
+	panicData, err := hex.DecodeString("4e487b710000000000000000000000000000000000000000000000000000000000000011")
+	require.NoError(t, err)
+	revert = DecodeContractRevert(panicData)
+	require.Equal(t, ContractRevertPanic, revert.Kind)
+	require.Equal(t, uint64(0x11), revert.PanicCode)
+	require.Equal(t, "execution reverted: panic 0x11 (arithmetic underflow or overflow)", revert.Error())
+
+	revert = DecodeContractRevert([]byte{0xca, 0xfe, 0xba, 0xbe})
+	require.Equal(t, ContractRevertCustom, revert.Kind)
+	require.Equal(t, "cafebabe", revert.GetSelector())
+	require.Equal(t, "execution reverted: custom error 0xcafebabe", revert.Error())
+
+	revert = DecodeContractRevert(nil)
+	require.Equal(t, ContractRevertUnknown, revert.Kind)
+	require.Equal(t, "execution reverted", revert.Error())
+}
+
+func TestUnitContractABIDecodeRevert(t *testing.T) {
+	t.Parallel()
+
+	contractABI := _TestContractABIWithErrors(t)
+	abiError := contractABI.GetABI().Errors["InsufficientBalance"]
+
+	args, err := abiError.Inputs.Pack(big.NewInt(1), big.NewInt(2))
+	require.NoError(t, err)
+	data := append(crypto.Keccak256([]byte(abiError.Sig))[:4], args...)
+
+	revert := contractABI.DecodeRevert(data)
+	require.Equal(t, ContractRevertCustom, revert.Kind)
+	require.Equal(t, "InsufficientBalance", revert.Name)
+	require.Equal(t, "InsufficientBalance(uint256,uint256)", revert.Signature)
+	require.Equal(t, []interface{}{big.NewInt(1), big.NewInt(2)}, revert.Args)
+	require.Equal(t, "execution reverted: InsufficientBalance(1, 2)", revert.Error())
+
+	revert = contractABI.DecodeRevert(_TestRevertReason(t, "paused"))
+	require.Equal(t, ContractRevertReason, revert.Kind)
+	require.Equal(t, "paused", revert.Reason)
+}
+
+func TestUnitContractFunctionResultGetRevertError(t *testing.T) {
+	t.Parallel()
+
+	data := _TestRevertReason(t, "too late")
+
+	require.Nil(t, ContractFunctionResult{ContractCallResult: data}.GetRevertError())
+
+	revert := ContractFunctionResult{ErrorMessage: "0x" + hex.EncodeToString(data)}.GetRevertError()
+	require.Equal(t, "too late", revert.Reason)
+
+	revert = ContractFunctionResult{ErrorMessage: "CONTRACT_REVERT_EXECUTED", ContractCallResult: data}.GetRevertError()
+	require.Equal(t, ContractRevertReason, revert.Kind)
+	require.Equal(t, "too late", revert.Reason)
+
+	revert = ContractFunctionResult{ErrorMessage: "INSUFFICIENT_GAS"}.GetRevertError()
+	require.Equal(t, ContractRevertUnknown, revert.Kind)
+	require.Equal(t, "execution reverted: INSUFFICIENT_GAS", revert.Error())
+}
+
+func TestUnitContractRevertErrorWrapped(t *testing.T) {
+	t.Parallel()
+
+	data := _TestRevertReason(t, "not the owner")
+
+	record := TransactionRecord{
+		Receipt:    TransactionReceipt{Status: StatusContractRevertExecuted},
+		CallResult: &ContractFunctionResult{ErrorMessage: "0x" + hex.EncodeToString(data)},
+	}
+	err := record.ValidateReceiptStatus(true)
+
+	var receiptErr ErrHederaReceiptStatus
+	require.ErrorAs(t, err, &receiptErr)
+	require.Equal(t, StatusContractRevertExecuted, receiptErr.Status)
+	assert.Equal(t, "exceptional receipt status: CONTRACT_REVERT_EXECUTED: execution reverted: not the owner", err.Error())
+
+	var revert *ContractRevertError
+	require.ErrorAs(t, err, &revert)
+	require.Equal(t, "not the owner", revert.Reason)
+
+	err = ErrMirrorNodeResponse{StatusCode: 400, Messages: []string{"CONTRACT_REVERT_EXECUTED"}, Data: "0x" + hex.EncodeToString(data)}
+	require.ErrorAs(t, err, &revert)
+	require.Equal(t, "not the owner", revert.Reason)
+
+	require.False(t, errors.As(ErrHederaReceiptStatus{Status: StatusInvalidSignature}, &revert))
+	require.False(t, errors.As(ErrMirrorNodeResponse{StatusCode: 404}, &revert))
+}
+
+func TestUnitMockContractCallQueryRevert(t *testing.T) {
+	t.Parallel()
+
+	data := _TestRevertReason(t, "not the owner")
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_ContractCallLocal{
+				ContractCallLocal: &services.ContractCallLocalResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_CONTRACT_REVERT_EXECUTED, ResponseType: services.ResponseType_ANSWER_ONLY},
+					FunctionResult: &services.ContractFunctionResult{
+						ContractID:         &services.ContractID{Contract: &services.ContractID_ContractNum{ContractNum: 123}},
+						ErrorMessage:       "0x" + hex.EncodeToString(data),
+						ContractCallResult: data,
+					},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, err := NewContractCallQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContractID(ContractID{Contract: 123}).
+		SetQueryPayment(NewHbar(1)).
+		SetGas(100000).
+		Execute(client)
+
+	var precheckErr ErrHederaPreCheckStatus
+	require.ErrorAs(t, err, &precheckErr)
+	require.Equal(t, StatusContractRevertExecuted, precheckErr.Status)
+
+	var revert *ContractRevertError
+	require.ErrorAs(t, err, &revert)
+	require.Equal(t, ContractRevertReason, revert.Kind)
+	require.Equal(t, "not the owner", revert.Reason)
+}
@@ -0,0 +1,524 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// StateDiff is the storage a contract execution read and wrote, grouped per contract, for reviewing what the
+// execution changed
+type StateDiff struct {
+	Contracts []ContractStateDiff
+}
+
+// ContractStateDiff is the storage of a single contract an execution read and wrote, ordered by slot
+type ContractStateDiff struct {
+	ContractID ContractID
+	Slots      []StorageSlotDiff
+}
+
+// StorageSlotDiff is a storage slot an execution read, and wrote when After isn't nil. Variables holds the
+// variables stored in the slot once decoded with ContractStateDiff.DecodeStorage.
+type StorageSlotDiff struct {
+	Slot      *big.Int
+	Before    *big.Int
+	After     *big.Int
+	Variables []StorageVariableDiff
+}
+
+// StorageVariableDiff is a variable of a storage slot, with its value before and after the execution. Integers
+// and enums decode to *big.Int, booleans to bool, addresses and contracts to a hex string, strings and bytes
+// short enough to be stored in their slot to string and []byte, and any other type to the raw bytes. After is
+// nil when the slot wasn't written.
+type StorageVariableDiff struct {
+	Name   string
+	Type   string
+	Before interface{}
+	After  interface{}
+}
+
+// NewStateDiff groups the storage changes per contract, merging the changes of the same slot
+func NewStateDiff(changes []ContractStateChange) StateDiff {
+	var diff StateDiff
+	index := make(map[string]int)
+
+	for _, change := range changes {
+		if change.ContractID == nil {
+			continue
+		}
+
+		key := change.ContractID.String()
+		i, ok := index[key]
+		if !ok {
+			i = len(diff.Contracts)
+			index[key] = i
+			diff.Contracts = append(diff.Contracts, ContractStateDiff{ContractID: *change.ContractID})
+		}
+
+		contract := &diff.Contracts[i]
+		for _, storage := range change.StorageChanges {
+			if storage == nil || storage.Slot == nil {
+				continue
+			}
+			contract._Merge(StorageSlotDiff{Slot: storage.Slot, Before: storage.ValueRead, After: storage.ValueWritten})
+		}
+	}
+
+	for i := range diff.Contracts {
+		slots := diff.Contracts[i].Slots
+		sort.Slice(slots, func(a, b int) bool {
+			return slots[a].Slot.Cmp(slots[b].Slot) < 0
+		})
+	}
+
+	return diff
+}
+
+func (diff *ContractStateDiff) _Merge(slot StorageSlotDiff) {
+	for i, existing := range diff.Slots {
+		if existing.Slot.Cmp(slot.Slot) != 0 {
+			continue
+		}
+		// the first read holds the value before, the last write the value after
+		if slot.After != nil {
+			diff.Slots[i].After = slot.After
+		}
+		return
+	}
+
+	diff.Slots = append(diff.Slots, slot)
+}
+
+// GetContract returns the storage diff of the contract
+func (diff StateDiff) GetContract(contractID ContractID) (ContractStateDiff, bool) {
+	for _, contract := range diff.Contracts {
+		if contract.ContractID.String() == contractID.String() {
+			return contract, true
+		}
+	}
+
+	return ContractStateDiff{}, false
+}
+
+// GetReads returns the slots which were only read
+func (diff ContractStateDiff) GetReads() []StorageSlotDiff {
+	reads := make([]StorageSlotDiff, 0)
+	for _, slot := range diff.Slots {
+		if slot.After == nil {
+			reads = append(reads, slot)
+		}
+	}
+
+	return reads
+}
+
+// GetWrites returns the slots which were written, even when with the value they held
+func (diff ContractStateDiff) GetWrites() []StorageSlotDiff {
+	writes := make([]StorageSlotDiff, 0)
+	for _, slot := range diff.Slots {
+		if slot.After != nil {
+			writes = append(writes, slot)
+		}
+	}
+
+	return writes
+}
+
+// IsChanged reports whether the slot was written with a value different from the one it held
+func (slot StorageSlotDiff) IsChanged() bool {
+	return slot.After != nil && (slot.Before == nil || slot.Before.Cmp(slot.After) != 0)
+}
+
+// String returns a report of the slots, and their variables when decoded, with their value before and after
+func (diff StateDiff) String() string {
+	var report strings.Builder
+	for _, contract := range diff.Contracts {
+		fmt.Fprintf(&report, "contract %s\n", contract.ContractID.String())
+		for _, slot := range contract.Slots {
+			if slot.After == nil {
+				fmt.Fprintf(&report, "  slot 0x%x read 0x%x\n", slot.Slot, _BigIntOrZero(slot.Before))
+			} else {
+				fmt.Fprintf(&report, "  slot 0x%x 0x%x -> 0x%x\n", slot.Slot, _BigIntOrZero(slot.Before), slot.After)
+			}
+			for _, variable := range slot.Variables {
+				if slot.After == nil {
+					fmt.Fprintf(&report, "    %s %s = %v\n", variable.Type, variable.Name, variable.Before)
+				} else {
+					fmt.Fprintf(&report, "    %s %s: %v -> %v\n", variable.Type, variable.Name, variable.Before, variable.After)
+				}
+			}
+		}
+	}
+
+	return report.String()
+}
+
+// GetStateDiffFromMirror returns the storage the contract execution of the TransactionID's transaction read and
+// wrote, as reported by the mirror node
+func (id TransactionID) GetStateDiffFromMirror(client *Client) (StateDiff, error) {
+	if client == nil {
+		return StateDiff{}, errNoClientProvided
+	}
+
+	result, err := MirrorClientFromClient(client).GetContractResult(context.Background(), id.String())
+	if err != nil {
+		return StateDiff{}, err
+	}
+
+	changes, err := result.ToContractStateChanges()
+	if err != nil {
+		return StateDiff{}, err
+	}
+
+	return NewStateDiff(changes), nil
+}
+
+// ToContractStateChanges converts the state changes of the result to the changes of a ContractFunctionResult
+func (result MirrorContractResult) ToContractStateChanges() ([]ContractStateChange, error) {
+	changes := make([]ContractStateChange, 0, len(result.StateChanges))
+	for _, change := range result.StateChanges {
+		contractID, err := ContractIDFromString(change.ContractID)
+		if err != nil {
+			return nil, err
+		}
+
+		storage := StorageChange{}
+		for _, value := range []struct {
+			text   string
+			target **big.Int
+		}{{change.Slot, &storage.Slot}, {change.ValueRead, &storage.ValueRead}, {change.ValueWritten, &storage.ValueWritten}} {
+			if value.text == "" {
+				continue
+			}
+			data, err := _MirrorHexDecode(value.text)
+			if err != nil {
+				return nil, err
+			}
+			*value.target = new(big.Int).SetBytes(data)
+		}
+
+		changes = append(changes, ContractStateChange{ContractID: &contractID, StorageChanges: []*StorageChange{&storage}})
+	}
+
+	return changes, nil
+}
+
+// StorageLayout is the storageLayout solc emits for a contract, describing the slot and offset of its state
+// variables
+type StorageLayout struct {
+	Storage []StorageLayoutVariable      `json:"storage"`
+	Types   map[string]StorageLayoutType `json:"types"`
+}
+
+// StorageLayoutVariable is a state variable of a StorageLayout, or a member of a struct
+type StorageLayoutVariable struct {
+	Contract string `json:"contract"`
+	Label    string `json:"label"`
+	Offset   int    `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// StorageLayoutType is a type of a StorageLayout. Encoding is "inplace", "mapping", "dynamic_array" or "bytes".
+type StorageLayoutType struct {
+	Encoding      string                  `json:"encoding"`
+	Label         string                  `json:"label"`
+	NumberOfBytes string                  `json:"numberOfBytes"`
+	Base          string                  `json:"base"`
+	Key           string                  `json:"key"`
+	Value         string                  `json:"value"`
+	Members       []StorageLayoutVariable `json:"members"`
+}
+
+// StorageLayoutFromJSON parses the storageLayout solc emits with `--storage-layout`, or the output of solc or
+// the artifact of Hardhat holding it
+func StorageLayoutFromJSON(data []byte) (*StorageLayout, error) {
+	var wrapper struct {
+		StorageLayout *StorageLayout `json:"storageLayout"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err == nil && wrapper.StorageLayout != nil {
+		return wrapper.StorageLayout, nil
+	}
+
+	var layout StorageLayout
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return nil, err
+	}
+	if layout.Types == nil && len(layout.Storage) > 0 {
+		return nil, errors.New("storage layout has no types")
+	}
+
+	return &layout, nil
+}
+
+// _StorageLocation is the place of a variable in a slot
+type _StorageLocation struct {
+	name     string
+	typeKey  string
+	offset   int
+	size     int
+	encoding string
+}
+
+// _StorageArrayRegion bounds the slots searched for the elements of a dynamic array or the data of long bytes,
+// which start at the hash of their slot
+var _StorageArrayRegion = new(big.Int).Lsh(big.NewInt(1), 64)
+
+var _StorageArrayLength = regexp.MustCompile(`\)(\d+)_storage$`)
+
+// DecodeStorage names the variables of the slots with the storage layout of the contract and decodes their
+// values before and after. Slots of mapping values are left undecoded as their keys can't be recovered.
+func (diff *ContractStateDiff) DecodeStorage(layout *StorageLayout) error {
+	if layout == nil {
+		return errors.New("no storage layout")
+	}
+
+	for i := range diff.Slots {
+		slot := &diff.Slots[i]
+		locations, err := layout._Members(layout.Storage, big.NewInt(0), "", slot.Slot)
+		if err != nil {
+			return err
+		}
+
+		slot.Variables = nil
+		for _, location := range locations {
+			variable := StorageVariableDiff{
+				Name:   location.name,
+				Type:   layout.Types[location.typeKey].Label,
+				Before: layout._Decode(location, _BigIntOrZero(slot.Before)),
+			}
+			if location.encoding == "dynamic_array" {
+				variable.Type = "uint256"
+			}
+			if slot.After != nil {
+				variable.After = layout._Decode(location, slot.After)
+			}
+			slot.Variables = append(slot.Variables, variable)
+		}
+	}
+
+	return nil
+}
+
+// DecodeStorage decodes the storage of the contract with its storage layout, see ContractStateDiff.DecodeStorage
+func (diff StateDiff) DecodeStorage(contractID ContractID, layout *StorageLayout) error {
+	for i := range diff.Contracts {
+		if diff.Contracts[i].ContractID.String() == contractID.String() {
+			return diff.Contracts[i].DecodeStorage(layout)
+		}
+	}
+
+	return errors.Errorf("no storage of contract %s", contractID.String())
+}
+
+// _Members returns the locations of the members, laid out from the base slot, stored in the slot
+func (layout *StorageLayout) _Members(members []StorageLayoutVariable, base *big.Int, prefix string, slot *big.Int) ([]_StorageLocation, error) {
+	var locations []_StorageLocation
+	for _, member := range members {
+		offset, ok := new(big.Int).SetString(member.Slot, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid slot %s of %s", member.Slot, member.Label)
+		}
+
+		found, err := layout._Locate(prefix+member.Label, member.Type, new(big.Int).Add(base, offset), member.Offset, slot)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, found...)
+	}
+
+	return locations, nil
+}
+
+// _Locate returns the locations of the variable of the type starting at the start slot which are stored in the slot
+func (layout *StorageLayout) _Locate(name string, typeKey string, start *big.Int, offset int, slot *big.Int) ([]_StorageLocation, error) {
+	t, ok := layout.Types[typeKey]
+	if !ok {
+		return nil, errors.Errorf("unknown type %s of %s", typeKey, name)
+	}
+	size, err := strconv.Atoi(t.NumberOfBytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "type %s", typeKey)
+	}
+
+	switch t.Encoding {
+	case "mapping":
+		return nil, nil
+
+	case "bytes":
+		if slot.Cmp(start) == 0 {
+			return []_StorageLocation{{name: name, typeKey: typeKey, size: 32, encoding: t.Encoding}}, nil
+		}
+		if index := _StorageRegionIndex(start, slot); index != nil {
+			return []_StorageLocation{{name: fmt.Sprintf("%s[data %d]", name, index), typeKey: "", size: 32}}, nil
+		}
+		return nil, nil
+
+	case "dynamic_array":
+		if slot.Cmp(start) == 0 {
+			return []_StorageLocation{{name: name + ".length", typeKey: typeKey, size: 32, encoding: t.Encoding}}, nil
+		}
+		if index := _StorageRegionIndex(start, slot); index != nil {
+			data := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(start).Bytes()))
+			return layout._Elements(name, t.Base, data, nil, slot)
+		}
+		return nil, nil
+	}
+
+	if len(t.Members) == 0 && t.Base == "" && (size < 1 || size > 32 || offset < 0 || offset+size > 32) {
+		return nil, errors.Errorf("type %s of %s doesn't fit in a slot at offset %d", typeKey, name, offset)
+	}
+
+	slots := big.NewInt(int64((size + 31) / 32))
+	if slot.Cmp(start) < 0 || slot.Cmp(new(big.Int).Add(start, slots)) >= 0 {
+		return nil, nil
+	}
+
+	if len(t.Members) > 0 {
+		return layout._Members(t.Members, start, name+".", slot)
+	}
+
+	if t.Base != "" {
+		match := _StorageArrayLength.FindStringSubmatch(typeKey)
+		if match == nil {
+			return nil, errors.Errorf("unknown length of %s", typeKey)
+		}
+		length, _ := new(big.Int).SetString(match[1], 10)
+		return layout._Elements(name, t.Base, start, length, slot)
+	}
+
+	return []_StorageLocation{{name: name, typeKey: typeKey, offset: offset, size: size, encoding: t.Encoding}}, nil
+}
+
+// _Elements returns the locations of the elements of the array starting at the start slot which are stored in the
+// slot. Elements of 16 bytes or fewer are packed into their slots. The length is nil for dynamic arrays.
+func (layout *StorageLayout) _Elements(name string, baseKey string, start *big.Int, length *big.Int, slot *big.Int) ([]_StorageLocation, error) {
+	base, ok := layout.Types[baseKey]
+	if !ok {
+		return nil, errors.Errorf("unknown type %s of %s", baseKey, name)
+	}
+	size, err := strconv.Atoi(base.NumberOfBytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "type %s", baseKey)
+	}
+
+	relative := new(big.Int).Sub(slot, start)
+
+	if size < 1 {
+		return nil, errors.Errorf("type %s of %s has no size", baseKey, name)
+	}
+
+	if size <= 16 && base.Encoding == "inplace" && len(base.Members) == 0 && base.Base == "" {
+		perSlot := int64(32 / size)
+		first := new(big.Int).Mul(relative, big.NewInt(perSlot))
+
+		var locations []_StorageLocation
+		for i := int64(0); i < perSlot; i++ {
+			index := new(big.Int).Add(first, big.NewInt(i))
+			if length != nil && index.Cmp(length) >= 0 {
+				break
+			}
+			locations = append(locations, _StorageLocation{
+				name:     fmt.Sprintf("%s[%s]", name, index.String()),
+				typeKey:  baseKey,
+				offset:   int(i) * size,
+				size:     size,
+				encoding: base.Encoding,
+			})
+		}
+		return locations, nil
+	}
+
+	slotsPerElement := big.NewInt(int64((size + 31) / 32))
+	index := new(big.Int).Div(relative, slotsPerElement)
+	if length != nil && index.Cmp(length) >= 0 {
+		return nil, nil
+	}
+
+	elementStart := new(big.Int).Add(start, new(big.Int).Mul(index, slotsPerElement))
+	return layout._Locate(fmt.Sprintf("%s[%s]", name, index.String()), baseKey, elementStart, 0, slot)
+}
+
+// _StorageRegionIndex returns the index of the slot in the region starting at the hash of the start slot, or nil
+// when the slot is outside of it
+func _StorageRegionIndex(start *big.Int, slot *big.Int) *big.Int {
+	data := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(start).Bytes()))
+	index := new(big.Int).Sub(slot, data)
+	if index.Sign() < 0 || index.Cmp(_StorageArrayRegion) >= 0 {
+		return nil
+	}
+
+	return index
+}
+
+// _Decode decodes the value of the variable at the location from the value of its slot
+func (layout *StorageLayout) _Decode(location _StorageLocation, value *big.Int) interface{} {
+	word := common.BigToHash(value).Bytes()
+	data := word[32-location.offset-location.size : 32-location.offset]
+
+	label := layout.Types[location.typeKey].Label
+	switch {
+	case location.encoding == "dynamic_array":
+		return new(big.Int).SetBytes(data)
+	case location.encoding == "bytes":
+		// short values are stored with twice their length in the lowest byte, long ones in the data region
+		if word[31]&1 == 1 {
+			return nil
+		}
+		// a length which doesn't fit the slot means the layout doesn't match the contract
+		length := int(word[31] / 2)
+		if length > 31 {
+			return append([]byte{}, word...)
+		}
+		content := append([]byte{}, word[:length]...)
+		if label == "string" {
+			return string(content)
+		}
+		return content
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "enum "):
+		return new(big.Int).SetBytes(data)
+	case strings.HasPrefix(label, "int"):
+		integer := new(big.Int).SetBytes(data)
+		if len(data) > 0 && data[0]&0x80 != 0 {
+			integer.Sub(integer, new(big.Int).Lsh(big.NewInt(1), uint(8*len(data))))
+		}
+		return integer
+	case label == "bool":
+		return data[len(data)-1] != 0
+	case strings.HasPrefix(label, "address"), strings.HasPrefix(label, "contract "):
+		return "0x" + hex.EncodeToString(data)
+	}
+
+	return append([]byte{}, data...)
+}
@@ -0,0 +1,218 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testStorageLayout = `{"storageLayout":{
+	"storage":[
+		{"contract":"Vault.sol:Vault","label":"total","offset":0,"slot":"0","type":"t_uint256"},
+		{"contract":"Vault.sol:Vault","label":"owner","offset":0,"slot":"1","type":"t_address"},
+		{"contract":"Vault.sol:Vault","label":"paused","offset":20,"slot":"1","type":"t_bool"},
+		{"contract":"Vault.sol:Vault","label":"delta","offset":21,"slot":"1","type":"t_int8"},
+		{"contract":"Vault.sol:Vault","label":"balances","offset":0,"slot":"2","type":"t_mapping(t_address,t_uint256)"},
+		{"contract":"Vault.sol:Vault","label":"values","offset":0,"slot":"3","type":"t_array(t_uint256)dyn_storage"},
+		{"contract":"Vault.sol:Vault","label":"name","offset":0,"slot":"4","type":"t_string_storage"},
+		{"contract":"Vault.sol:Vault","label":"config","offset":0,"slot":"5","type":"t_struct(Config)12_storage"},
+		{"contract":"Vault.sol:Vault","label":"small","offset":0,"slot":"7","type":"t_array(t_uint16)3_storage"}
+	],
+	"types":{
+		"t_address":{"encoding":"inplace","label":"address","numberOfBytes":"20"},
+		"t_bool":{"encoding":"inplace","label":"bool","numberOfBytes":"1"},
+		"t_int8":{"encoding":"inplace","label":"int8","numberOfBytes":"1"},
+		"t_uint16":{"encoding":"inplace","label":"uint16","numberOfBytes":"2"},
+		"t_uint128":{"encoding":"inplace","label":"uint128","numberOfBytes":"16"},
+		"t_uint256":{"encoding":"inplace","label":"uint256","numberOfBytes":"32"},
+		"t_string_storage":{"encoding":"bytes","label":"string","numberOfBytes":"32"},
+		"t_mapping(t_address,t_uint256)":{"encoding":"mapping","key":"t_address","label":"mapping(address => uint256)","numberOfBytes":"32","value":"t_uint256"},
+		"t_array(t_uint256)dyn_storage":{"base":"t_uint256","encoding":"dynamic_array","label":"uint256[]","numberOfBytes":"32"},
+		"t_array(t_uint16)3_storage":{"base":"t_uint16","encoding":"inplace","label":"uint16[3]","numberOfBytes":"32"},
+		"t_struct(Config)12_storage":{"encoding":"inplace","label":"struct Vault.Config","numberOfBytes":"64","members":[
+			{"contract":"Vault.sol:Vault","label":"low","offset":0,"slot":"0","type":"t_uint128"},
+			{"contract":"Vault.sol:Vault","label":"high","offset":16,"slot":"0","type":"t_uint128"},
+			{"contract":"Vault.sol:Vault","label":"admin","offset":0,"slot":"1","type":"t_address"}]}
+	}
+}}`
+
+func _TestStorageWord(hex string) *big.Int {
+	return new(big.Int).SetBytes(common.FromHex(hex))
+}
+
+func TestUnitStateDiffGrouping(t *testing.T) {
+	t.Parallel()
+
+	first, second := ContractID{Contract: 5}, ContractID{Contract: 6}
+	diff := NewStateDiff([]ContractStateChange{
+		{ContractID: &first, StorageChanges: []*StorageChange{
+			{Slot: big.NewInt(2), ValueRead: big.NewInt(7)},
+			{Slot: big.NewInt(0), ValueRead: big.NewInt(1), ValueWritten: big.NewInt(1)},
+		}},
+		{ContractID: &second, StorageChanges: []*StorageChange{{Slot: big.NewInt(0), ValueRead: big.NewInt(0), ValueWritten: big.NewInt(3)}}},
+		{ContractID: &first, StorageChanges: []*StorageChange{{Slot: big.NewInt(2), ValueRead: big.NewInt(8), ValueWritten: big.NewInt(9)}}},
+	})
+
+	require.Len(t, diff.Contracts, 2)
+	contract, ok := diff.GetContract(first)
+	require.True(t, ok)
+	require.Len(t, contract.Slots, 2)
+	require.Equal(t, big.NewInt(0), contract.Slots[0].Slot)
+	require.False(t, contract.Slots[0].IsChanged())
+	require.Equal(t, big.NewInt(7), contract.Slots[1].Before)
+	require.Equal(t, big.NewInt(9), contract.Slots[1].After)
+	require.True(t, contract.Slots[1].IsChanged())
+	require.Len(t, contract.GetWrites(), 2)
+	require.Len(t, contract.GetReads(), 0)
+
+	_, ok = diff.GetContract(ContractID{Contract: 7})
+	require.False(t, ok)
+	require.Contains(t, diff.String(), "contract 0.0.6\n  slot 0x0 0x0 -> 0x3\n")
+}
+
+func TestUnitStateDiffDecodeStorage(t *testing.T) {
+	t.Parallel()
+
+	layout, err := StorageLayoutFromJSON([]byte(testStorageLayout))
+	require.NoError(t, err)
+
+	valuesData := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(big.NewInt(3)).Bytes()))
+	balanceSlot := new(big.Int).SetBytes(crypto.Keccak256(append(common.LeftPadBytes([]byte{0xab}, 32), common.BigToHash(big.NewInt(2)).Bytes()...)))
+
+	contractID := ContractID{Contract: 5}
+	diff := NewStateDiff([]ContractStateChange{{ContractID: &contractID, StorageChanges: []*StorageChange{
+		{Slot: big.NewInt(0), ValueRead: big.NewInt(100), ValueWritten: big.NewInt(150)},
+		{Slot: big.NewInt(1), ValueRead: _TestStorageWord("0x00000000000000000000ff01000000000000000000000000000000000000abcd")},
+		{Slot: big.NewInt(3), ValueRead: big.NewInt(1), ValueWritten: big.NewInt(2)},
+		{Slot: new(big.Int).Add(valuesData, big.NewInt(1)), ValueRead: big.NewInt(0), ValueWritten: big.NewInt(42)},
+		{Slot: big.NewInt(4), ValueRead: big.NewInt(0), ValueWritten: _TestStorageWord("0x6869000000000000000000000000000000000000000000000000000000000004")},
+		{Slot: big.NewInt(5), ValueRead: _TestStorageWord("0x0000000000000000000000000000000200000000000000000000000000000001")},
+		{Slot: big.NewInt(7), ValueRead: _TestStorageWord("0x0000000000000000000000000000000000000000000000000000000200010000")},
+		{Slot: balanceSlot, ValueRead: big.NewInt(10), ValueWritten: big.NewInt(0)},
+	}}})
+	require.NoError(t, diff.DecodeStorage(contractID, layout))
+	require.Error(t, diff.DecodeStorage(ContractID{Contract: 6}, layout))
+
+	contract, _ := diff.GetContract(contractID)
+	variables := make(map[string]StorageVariableDiff)
+	for _, slot := range contract.Slots {
+		for _, variable := range slot.Variables {
+			variables[variable.Name] = variable
+		}
+	}
+
+	require.Equal(t, StorageVariableDiff{Name: "total", Type: "uint256", Before: big.NewInt(100), After: big.NewInt(150)}, variables["total"])
+	require.Equal(t, "0x000000000000000000000000000000000000abcd", variables["owner"].Before)
+	require.Nil(t, variables["owner"].After)
+	require.Equal(t, true, variables["paused"].Before)
+	require.Equal(t, big.NewInt(-1), variables["delta"].Before)
+	require.Equal(t, big.NewInt(2), variables["values.length"].After)
+	require.Equal(t, big.NewInt(42), variables["values[1]"].After)
+	require.Equal(t, "", variables["name"].Before)
+	require.Equal(t, "hi", variables["name"].After)
+	require.Equal(t, big.NewInt(1), variables["config.low"].Before)
+	require.Equal(t, big.NewInt(2), variables["config.high"].Before)
+	require.Zero(t, variables["small[0]"].Before.(*big.Int).Sign())
+	require.Equal(t, big.NewInt(1), variables["small[1]"].Before)
+	require.Equal(t, big.NewInt(2), variables["small[2]"].Before)
+	require.Len(t, variables, 12)
+	require.Equal(t, "uint256", variables["values.length"].Type)
+
+	require.Contains(t, diff.String(), "uint256 total: 100 -> 150")
+	for _, slot := range contract.Slots {
+		if slot.Slot.Cmp(balanceSlot) == 0 {
+			require.Empty(t, slot.Variables)
+		}
+	}
+}
+
+func TestUnitStateDiffDecodeStorageMismatchedLayout(t *testing.T) {
+	t.Parallel()
+
+	layout, err := StorageLayoutFromJSON([]byte(testStorageLayout))
+	require.NoError(t, err)
+
+	// a slot of another contract whose lowest byte reads as a short string longer than the slot
+	contractID := ContractID{Contract: 5}
+	word := _TestStorageWord("0x00000000000000000000000000000000000000000000000000000000000000fe")
+	diff := NewStateDiff([]ContractStateChange{{ContractID: &contractID, StorageChanges: []*StorageChange{
+		{Slot: big.NewInt(4), ValueRead: word},
+	}}})
+	require.NoError(t, diff.DecodeStorage(contractID, layout))
+
+	contract, _ := diff.GetContract(contractID)
+	require.Len(t, contract.Slots[0].Variables, 1)
+	require.Equal(t, common.BigToHash(word).Bytes(), contract.Slots[0].Variables[0].Before)
+
+	for _, numberOfBytes := range []string{"0", "33"} {
+		malformed, err := StorageLayoutFromJSON([]byte(`{"storage":[{"label":"x","offset":0,"slot":"0","type":"t_x"},{"label":"y","offset":0,"slot":"1","type":"t_array(t_x)2_storage"}],` +
+			`"types":{"t_x":{"encoding":"inplace","label":"uint8","numberOfBytes":"` + numberOfBytes + `"},` +
+			`"t_array(t_x)2_storage":{"base":"t_x","encoding":"inplace","label":"uint8[2]","numberOfBytes":"32"}}}`))
+		require.NoError(t, err)
+
+		for _, slot := range []int64{0, 1} {
+			diff := NewStateDiff([]ContractStateChange{{ContractID: &contractID, StorageChanges: []*StorageChange{
+				{Slot: big.NewInt(slot), ValueRead: big.NewInt(1)},
+			}}})
+			require.Error(t, diff.DecodeStorage(contractID, malformed), "numberOfBytes %s, slot %d", numberOfBytes, slot)
+		}
+	}
+}
+
+func TestUnitTransactionIDGetStateDiffFromMirror(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/contracts/results/0.0.2-1700000000-000000123", r.URL.Path)
+		_, _ = w.Write([]byte(`{"contract_id":"0.0.5","result":"SUCCESS","state_changes":[
+			{"address":"0x0000000000000000000000000000000000000005","contract_id":"0.0.5","slot":"0x0000000000000000000000000000000000000000000000000000000000000000",
+			 "value_read":"0x0000000000000000000000000000000000000000000000000000000000000064","value_written":"0x0000000000000000000000000000000000000000000000000000000000000096"},
+			{"address":"0x0000000000000000000000000000000000000005","contract_id":"0.0.5","slot":"0x0000000000000000000000000000000000000000000000000000000000000001",
+			 "value_read":"0x0000000000000000000000000000000000000000000000000000000000000001","value_written":null}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	diff, err := NewTransactionIDWithValidStart(AccountID{Account: 2}, time.Unix(1700000000, 123)).GetStateDiffFromMirror(client)
+	require.NoError(t, err)
+
+	contract, ok := diff.GetContract(ContractID{Contract: 5})
+	require.True(t, ok)
+	require.Len(t, contract.GetWrites(), 1)
+	require.Equal(t, big.NewInt(150), contract.GetWrites()[0].After)
+	require.Len(t, contract.GetReads(), 1)
+	require.Nil(t, contract.GetReads()[0].After)
+}
@@ -450,6 +450,18 @@ func (tx *ContractUpdateTransaction) Execute(client *Client) (TransactionRespons
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the ContractUpdateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *ContractUpdateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed ContractUpdateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *ContractUpdateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *ContractUpdateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -0,0 +1,273 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// ERC20ABI is the ABI JSON of the ERC-20 interface HTS fungible tokens implement at their address
+const ERC20ABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable",
+		"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+		"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Approval","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},
+		{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+// ERC721ABI is the ABI JSON of the ERC-721 interface HTS non fungible tokens implement at their address
+const ERC721ABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"ownerOf","stateMutability":"view",
+		"inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"tokenURI","stateMutability":"view",
+		"inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"getApproved","stateMutability":"view",
+		"inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"isApprovedForAll","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"},{"name":"operator","type":"address"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"payable",
+		"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"approve","stateMutability":"payable",
+		"inputs":[{"name":"approved","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"setApprovalForAll","stateMutability":"nonpayable",
+		"inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"Approval","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},
+		{"name":"approved","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"ApprovalForAll","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},
+		{"name":"operator","type":"address","indexed":true},{"name":"approved","type":"bool","indexed":false}]}
+]`
+
+var (
+	_ERC20ContractABI  = _MustContractABI(ERC20ABI)
+	_ERC721ContractABI = _MustContractABI(ERC721ABI)
+)
+
+// ERC20Token calls an HTS fungible token through the ERC-20 interface at its address. Reads are made with
+// ContractCallQuery, writes are returned as a ContractExecuteTransaction to be executed by the caller, whose
+// account is the one transferring or approving. Amounts are in the smallest denomination of the token.
+type ERC20Token struct {
+	TokenID TokenID
+	// CallGas is the gas of the ContractCallQuery of the reads
+	CallGas uint64
+	// ExecuteGas is the gas of the ContractExecuteTransaction of the writes
+	ExecuteGas uint64
+}
+
+// ERC20 returns the ERC-20 facade of the fungible token
+func ERC20(tokenID TokenID) *ERC20Token {
+	return &ERC20Token{TokenID: tokenID, CallGas: 100000, ExecuteGas: 100000}
+}
+
+// Name returns the name of the token
+func (token *ERC20Token) Name(client *Client) (string, error) {
+	var name string
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC20ContractABI, &name, "name")
+	return name, err
+}
+
+// Symbol returns the symbol of the token
+func (token *ERC20Token) Symbol(client *Client) (string, error) {
+	var symbol string
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC20ContractABI, &symbol, "symbol")
+	return symbol, err
+}
+
+// Decimals returns the number of decimals of the token
+func (token *ERC20Token) Decimals(client *Client) (uint8, error) {
+	var decimals uint8
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC20ContractABI, &decimals, "decimals")
+	return decimals, err
+}
+
+// TotalSupply returns the total supply of the token
+func (token *ERC20Token) TotalSupply(client *Client) (uint64, error) {
+	var supply uint64
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC20ContractABI, &supply, "totalSupply")
+	return supply, err
+}
+
+// BalanceOf returns the balance of the token of the account
+func (token *ERC20Token) BalanceOf(client *Client, accountID AccountID) (uint64, error) {
+	var balance uint64
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC20ContractABI, &balance, "balanceOf", accountID)
+	return balance, err
+}
+
+// Allowance returns the amount of the token the owner allows the spender to transfer
+func (token *ERC20Token) Allowance(client *Client, owner AccountID, spender AccountID) (uint64, error) {
+	var allowance uint64
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC20ContractABI, &allowance, "allowance", owner, spender)
+	return allowance, err
+}
+
+// Transfer returns the transaction transferring the amount of the token from the caller to the account
+func (token *ERC20Token) Transfer(to AccountID, amount uint64) (*ContractExecuteTransaction, error) {
+	return _ERCExecute(token.TokenID, token.ExecuteGas, _ERC20ContractABI, "transfer", to, amount)
+}
+
+// TransferFrom returns the transaction transferring the amount of the token from the account to the other, out of
+// the allowance of the caller
+func (token *ERC20Token) TransferFrom(from AccountID, to AccountID, amount uint64) (*ContractExecuteTransaction, error) {
+	return _ERCExecute(token.TokenID, token.ExecuteGas, _ERC20ContractABI, "transferFrom", from, to, amount)
+}
+
+// Approve returns the transaction allowing the spender to transfer the amount of the token of the caller
+func (token *ERC20Token) Approve(spender AccountID, amount uint64) (*ContractExecuteTransaction, error) {
+	return _ERCExecute(token.TokenID, token.ExecuteGas, _ERC20ContractABI, "approve", spender, amount)
+}
+
+// ERC721Token calls an HTS non fungible token through the ERC-721 interface at its address. Reads are made with
+// ContractCallQuery, writes are returned as a ContractExecuteTransaction to be executed by the caller, whose
+// account is the one transferring or approving. NFTs are identified by their serial number.
+type ERC721Token struct {
+	TokenID TokenID
+	// CallGas is the gas of the ContractCallQuery of the reads
+	CallGas uint64
+	// ExecuteGas is the gas of the ContractExecuteTransaction of the writes
+	ExecuteGas uint64
+}
+
+// ERC721 returns the ERC-721 facade of the non fungible token
+func ERC721(tokenID TokenID) *ERC721Token {
+	return &ERC721Token{TokenID: tokenID, CallGas: 100000, ExecuteGas: 100000}
+}
+
+// Name returns the name of the token
+func (token *ERC721Token) Name(client *Client) (string, error) {
+	var name string
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &name, "name")
+	return name, err
+}
+
+// Symbol returns the symbol of the token
+func (token *ERC721Token) Symbol(client *Client) (string, error) {
+	var symbol string
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &symbol, "symbol")
+	return symbol, err
+}
+
+// TotalSupply returns the number of NFTs of the token
+func (token *ERC721Token) TotalSupply(client *Client) (uint64, error) {
+	var supply uint64
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &supply, "totalSupply")
+	return supply, err
+}
+
+// BalanceOf returns the number of NFTs of the token the account owns
+func (token *ERC721Token) BalanceOf(client *Client, accountID AccountID) (uint64, error) {
+	var balance uint64
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &balance, "balanceOf", accountID)
+	return balance, err
+}
+
+// OwnerOf returns the account owning the NFT, by its EVM address when that isn't derived from its number
+func (token *ERC721Token) OwnerOf(client *Client, serialNumber int64) (AccountID, error) {
+	var owner AccountID
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &owner, "ownerOf", serialNumber)
+	return owner, err
+}
+
+// TokenURI returns the metadata of the NFT as a string
+func (token *ERC721Token) TokenURI(client *Client, serialNumber int64) (string, error) {
+	var uri string
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &uri, "tokenURI", serialNumber)
+	return uri, err
+}
+
+// GetApproved returns the account approved to transfer the NFT
+func (token *ERC721Token) GetApproved(client *Client, serialNumber int64) (AccountID, error) {
+	var approved AccountID
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &approved, "getApproved", serialNumber)
+	return approved, err
+}
+
+// IsApprovedForAll returns whether the operator is approved to transfer all NFTs of the token the owner owns
+func (token *ERC721Token) IsApprovedForAll(client *Client, owner AccountID, operator AccountID) (bool, error) {
+	var approved bool
+	err := _ERCCall(client, token.TokenID, token.CallGas, _ERC721ContractABI, &approved, "isApprovedForAll", owner, operator)
+	return approved, err
+}
+
+// TransferFrom returns the transaction transferring the NFT from the account to the other, which the caller owns or
+// is approved to transfer
+func (token *ERC721Token) TransferFrom(from AccountID, to AccountID, serialNumber int64) (*ContractExecuteTransaction, error) {
+	return _ERCExecute(token.TokenID, token.ExecuteGas, _ERC721ContractABI, "transferFrom", from, to, serialNumber)
+}
+
+// Approve returns the transaction approving the account to transfer the NFT of the caller
+func (token *ERC721Token) Approve(approved AccountID, serialNumber int64) (*ContractExecuteTransaction, error) {
+	return _ERCExecute(token.TokenID, token.ExecuteGas, _ERC721ContractABI, "approve", approved, serialNumber)
+}
+
+// SetApprovalForAll returns the transaction approving or revoking the operator to transfer all NFTs of the token
+// the caller owns
+func (token *ERC721Token) SetApprovalForAll(operator AccountID, approved bool) (*ContractExecuteTransaction, error) {
+	return _ERCExecute(token.TokenID, token.ExecuteGas, _ERC721ContractABI, "setApprovalForAll", operator, approved)
+}
+
+// _ERCCall calls the read function of the token with ContractCallQuery and decodes its only output into out
+func _ERCCall(client *Client, tokenID TokenID, gas uint64, contractABI *ContractABI, out interface{}, name string, args ...interface{}) error {
+	params, err := contractABI.Pack(name, args...)
+	if err != nil {
+		return err
+	}
+
+	result, err := NewContractCallQuery().
+		SetContractID(HRCContractID(tokenID)).
+		SetGas(gas).
+		SetFunctionParameters(params).
+		Execute(client)
+	if err != nil {
+		return err
+	}
+
+	return contractABI.UnpackInto(out, name, result.ContractCallResult)
+}
+
+// _ERCExecute returns the ContractExecuteTransaction calling the write function of the token
+func _ERCExecute(tokenID TokenID, gas uint64, contractABI *ContractABI, name string, args ...interface{}) (*ContractExecuteTransaction, error) {
+	params, err := contractABI.Pack(name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewContractExecuteTransaction().
+		SetContractID(HRCContractID(tokenID)).
+		SetGas(gas).
+		SetFunctionParameters(params), nil
+}
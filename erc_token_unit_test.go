@@ -0,0 +1,130 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestERCCallResponses(t *testing.T, tokenID TokenID, selector string, output []byte) [][]interface{} {
+	call := func(responseType services.ResponseType) func(request *services.Query) *services.Response {
+		return func(request *services.Query) *services.Response {
+			query := request.GetContractCallLocal()
+			require.NotNil(t, query)
+			require.Equal(t, responseType, query.Header.ResponseType)
+			require.Equal(t, HRCContractID(tokenID)._ToProtobuf().String(), query.ContractID.String())
+			require.Equal(t, selector, hex.EncodeToString(query.FunctionParameters[:4]))
+
+			return &services.Response{
+				Response: &services.Response_ContractCallLocal{
+					ContractCallLocal: &services.ContractCallLocalResponse{
+						Header: &services.ResponseHeader{
+							NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+							ResponseType:                responseType,
+							Cost:                        2,
+						},
+						FunctionResult: &services.ContractFunctionResult{ContractCallResult: output},
+					},
+				},
+			}
+		}
+	}
+
+	return [][]interface{}{{call(services.ResponseType_COST_ANSWER), call(services.ResponseType_ANSWER_ONLY)}}
+}
+
+func TestUnitERC20BalanceOf(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 1234}
+	client, server := NewMockClientAndServer(_TestERCCallResponses(t, tokenID, "70a08231",
+		_TestPackOutputs(t, _ERC20ContractABI, "balanceOf", uint64(4200))))
+	defer server.Close()
+
+	balance, err := ERC20(tokenID).BalanceOf(client, AccountID{Account: 5})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4200), balance)
+}
+
+func TestUnitERC721OwnerOf(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 1234}
+	client, server := NewMockClientAndServer(_TestERCCallResponses(t, tokenID, "6352211e",
+		_TestPackOutputs(t, _ERC721ContractABI, "ownerOf", AccountID{Account: 5})))
+	defer server.Close()
+
+	owner, err := ERC721(tokenID).OwnerOf(client, 1)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 5}, owner)
+}
+
+func TestUnitERC20Writes(t *testing.T) {
+	t.Parallel()
+
+	token := ERC20(TokenID{Token: 1234})
+	token.ExecuteGas = 50000
+
+	tx, err := token.Transfer(AccountID{Account: 5}, 100)
+	require.NoError(t, err)
+	require.Equal(t, HRCContractID(token.TokenID), tx.GetContractID())
+	require.Equal(t, uint64(50000), tx.GetGas())
+	require.Equal(t, "a9059cbb"+
+		"0000000000000000000000000000000000000000000000000000000000000005"+
+		"0000000000000000000000000000000000000000000000000000000000000064", hex.EncodeToString(tx.GetFunctionParameters()))
+
+	tx, err = token.TransferFrom(AccountID{Account: 5}, AccountID{Account: 6}, 100)
+	require.NoError(t, err)
+	require.Equal(t, "23b872dd", hex.EncodeToString(tx.GetFunctionParameters()[:4]))
+
+	tx, err = token.Approve(AccountID{Account: 6}, 100)
+	require.NoError(t, err)
+	require.Equal(t, "095ea7b3", hex.EncodeToString(tx.GetFunctionParameters()[:4]))
+}
+
+func TestUnitERC721Writes(t *testing.T) {
+	t.Parallel()
+
+	token := ERC721(TokenID{Token: 1234})
+
+	tx, err := token.TransferFrom(AccountID{Account: 5}, AccountID{Account: 6}, 3)
+	require.NoError(t, err)
+	require.Equal(t, HRCContractID(token.TokenID), tx.GetContractID())
+	require.Equal(t, "23b872dd", hex.EncodeToString(tx.GetFunctionParameters()[:4]))
+
+	tx, err = token.Approve(AccountID{Account: 6}, 3)
+	require.NoError(t, err)
+	require.Equal(t, "095ea7b3", hex.EncodeToString(tx.GetFunctionParameters()[:4]))
+
+	tx, err = token.SetApprovalForAll(AccountID{Account: 6}, true)
+	require.NoError(t, err)
+	require.Equal(t, "a22cb465", hex.EncodeToString(tx.GetFunctionParameters()[:4]))
+
+	_, err = token.TransferFrom(AccountID{Account: 5}, AccountID{Account: 6}, -1)
+	require.Error(t, err)
+}
@@ -21,8 +21,11 @@ package hedera
  */
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	// "reflect"
 
@@ -113,10 +116,15 @@ func (e ErrHederaNetwork) Error() string {
 type ErrHederaPreCheckStatus struct {
 	TxID   TransactionID
 	Status Status
+	// Revert is the decoded revert of a ContractCallQuery failing with CONTRACT_REVERT_EXECUTED
+	Revert *ContractRevertError
 }
 
 // Error() implements the Error interface
 func (e ErrHederaPreCheckStatus) Error() string {
+	if e.Revert != nil {
+		return fmt.Sprintf("exceptional precheck status %s: %s", e.Status.String(), e.Revert.Error())
+	}
 	if e.TxID.AccountID == nil {
 		return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
 	}
@@ -126,11 +134,23 @@ func (e ErrHederaPreCheckStatus) Error() string {
 	return fmt.Sprintf("exceptional precheck status %s received for transaction %v", e.Status.String(), e.TxID)
 }
 
+// Unwrap returns the decoded revert of the contract call, if any
+func (e ErrHederaPreCheckStatus) Unwrap() error {
+	if e.Revert == nil {
+		return nil
+	}
+
+	return e.Revert
+}
+
 // ErrHederaReceiptStatus is returned by TransactionID.GetReceipt if the status of the receipt is exceptional.
 type ErrHederaReceiptStatus struct {
 	TxID    TransactionID
 	Status  Status
 	Receipt TransactionReceipt
+	// Revert is the decoded revert of a contract call failing with CONTRACT_REVERT_EXECUTED. Receipts carry no call
+	// result, so it is only known when the error comes from a record.
+	Revert *ContractRevertError
 }
 
 func _NewErrHederaReceiptStatus(id TransactionID, status Status) ErrHederaReceiptStatus {
@@ -139,27 +159,134 @@ func _NewErrHederaReceiptStatus(id TransactionID, status Status) ErrHederaReceip
 
 // Error() implements the Error interface
 func (e ErrHederaReceiptStatus) Error() string {
+	if e.Revert != nil {
+		return fmt.Sprintf("exceptional receipt status: %s: %s", e.Status.String(), e.Revert.Error())
+	}
 	return fmt.Sprintf("exceptional receipt status: %s", e.Status.String())
 }
 
+// Unwrap returns the decoded revert of the contract call, if any
+func (e ErrHederaReceiptStatus) Unwrap() error {
+	if e.Revert == nil {
+		return nil
+	}
+
+	return e.Revert
+}
+
 // ErrHederaRecordStatus is returned by TransactionID.GetRecord if the status of the record is exceptional.
 type ErrHederaRecordStatus struct {
 	TxID   TransactionID
 	Status Status
+	// Revert is the decoded revert of a contract call failing with CONTRACT_REVERT_EXECUTED
+	Revert *ContractRevertError
 }
 
 // Error() implements the Error interface
 func (e ErrHederaRecordStatus) Error() string {
+	if e.Revert != nil {
+		return fmt.Sprintf("exceptional precheck status %s: %s", e.Status.String(), e.Revert.Error())
+	}
 	return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
 }
 
-// ErrLocalValidation is returned by TransactionBuilder.Build(*Client) and QueryBuilder.Execute(*Client)
-// if the constructed transaction or query fails local sanity checks.
+// Unwrap returns the decoded revert of the contract call, if any
+func (e ErrHederaRecordStatus) Unwrap() error {
+	if e.Revert == nil {
+		return nil
+	}
+
+	return e.Revert
+}
+
+// ErrLocalValidation is returned by Transaction.Validate, and by FreezeWith when the client has
+// automatic validation enabled, if the constructed transaction or query fails local sanity checks.
 type ErrLocalValidation struct {
 	message string
+	// Every local check which failed, in the order they were run
+	Findings []ValidationFinding
+}
+
+// ValidationFinding describes a single local check which a transaction failed.
+type ValidationFinding struct {
+	// The precheck status the network is expected to respond with for this problem
+	Status Status
+	// Human readable description of the problem
+	Message string
 }
 
 // Error() implements the Error interface
 func (e ErrLocalValidation) Error() string {
-	return e.message
+	if e.message != "" || len(e.Findings) == 0 {
+		return e.message
+	}
+
+	messages := make([]string, 0, len(e.Findings))
+	for _, finding := range e.Findings {
+		messages = append(messages, fmt.Sprintf("%s: %s", finding.Status.String(), finding.Message))
+	}
+
+	return fmt.Sprintf("local validation failed: %s", strings.Join(messages, "; "))
+}
+
+// ErrMirrorNodeResponse is returned by MirrorClient when the mirror node answers a request with a
+// non-success HTTP status.
+type ErrMirrorNodeResponse struct {
+	URL        string
+	StatusCode int
+	// The messages of the `_status` object in the response body, if any
+	Messages []string
+	// The hex encoded data of the `_status` object, such as the revert reason of a contract call
+	Data string
+}
+
+// Error() implements the Error interface
+func (e ErrMirrorNodeResponse) Error() string {
+	if len(e.Messages) == 0 {
+		return fmt.Sprintf("mirror node request to %s failed with status code %d", e.URL, e.StatusCode)
+	}
+
+	return fmt.Sprintf("mirror node request to %s failed with status code %d: %s", e.URL, e.StatusCode, strings.Join(e.Messages, "; "))
+}
+
+// Unwrap returns the decoded revert of a contract call the mirror node simulated, if the response has revert data
+func (e ErrMirrorNodeResponse) Unwrap() error {
+	data, err := hex.DecodeString(strings.TrimPrefix(e.Data, "0x"))
+	if err != nil || len(data) < 4 {
+		return nil
+	}
+
+	return DecodeContractRevert(data)
+}
+
+// ErrMirrorNodeIngestionTimeout is returned when the mirror node didn't catch up with the network
+// within the polling timeout.
+type ErrMirrorNodeIngestionTimeout struct {
+	Timestamp time.Time
+}
+
+// Error() implements the Error interface
+func (e ErrMirrorNodeIngestionTimeout) Error() string {
+	return fmt.Sprintf("mirror node did not ingest consensus timestamp %s in time", e.Timestamp.String())
+}
+
+// ErrTopicSubscriptionOverflow is sent on the error channel of TopicMessageQuery.SubscribeChan when its buffer is
+// full and the overflow policy is TopicOverflowError.
+type ErrTopicSubscriptionOverflow struct {
+	BufferSize int
+}
+
+// Error() implements the Error interface
+func (e ErrTopicSubscriptionOverflow) Error() string {
+	return fmt.Sprintf("topic subscription buffer of %d messages overflowed", e.BufferSize)
+}
+
+// ErrAliasNotFound is returned by AliasResolver when the mirror node knows no account or contract with the alias.
+type ErrAliasNotFound struct {
+	Alias string
+}
+
+// Error() implements the Error interface
+func (e ErrAliasNotFound) Error() string {
+	return fmt.Sprintf("no account or contract found for alias %s", e.Alias)
 }
@@ -266,6 +266,18 @@ func (tx *EthereumTransaction) Execute(client *Client) (TransactionResponse, err
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the EthereumTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *EthereumTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed EthereumTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *EthereumTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *EthereumTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
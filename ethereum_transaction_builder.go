@@ -0,0 +1,358 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// The chain IDs of the EVM of the Hedera networks, which signed Ethereum transactions commit to
+const (
+	EthereumChainIDMainnet    uint64 = 295
+	EthereumChainIDTestnet    uint64 = 296
+	EthereumChainIDPreviewnet uint64 = 297
+	EthereumChainIDLocal      uint64 = 298
+)
+
+// EthereumChainIDForLedgerID returns the chain ID of the EVM of the network with the ledger ID
+func EthereumChainIDForLedgerID(ledgerID *LedgerID) (uint64, error) {
+	switch {
+	case ledgerID == nil:
+		return 0, errors.New("ledger ID is not set")
+	case ledgerID.IsMainnet():
+		return EthereumChainIDMainnet, nil
+	case ledgerID.IsTestnet():
+		return EthereumChainIDTestnet, nil
+	case ledgerID.IsPreviewnet():
+		return EthereumChainIDPreviewnet, nil
+	}
+
+	return 0, fmt.Errorf("no known chain ID for ledger %s", ledgerID.String())
+}
+
+// EthereumTransactionType is the EIP-2718 type of an Ethereum transaction
+type EthereumTransactionType uint8
+
+const (
+	// EthereumTransactionTypeLegacy is a pre EIP-2718 transaction with a gas price, signed as in EIP-155
+	EthereumTransactionTypeLegacy EthereumTransactionType = types.LegacyTxType
+	// EthereumTransactionTypeAccessList is an EIP-2930 transaction with a gas price and an access list
+	EthereumTransactionTypeAccessList EthereumTransactionType = types.AccessListTxType
+	// EthereumTransactionTypeDynamicFee is an EIP-1559 transaction with a max fee and priority fee
+	EthereumTransactionTypeDynamicFee EthereumTransactionType = types.DynamicFeeTxType
+)
+
+// String returns a string representation of the EthereumTransactionType
+func (txType EthereumTransactionType) String() string {
+	switch txType {
+	case EthereumTransactionTypeLegacy:
+		return "LEGACY"
+	case EthereumTransactionTypeAccessList:
+		return "ACCESS_LIST"
+	case EthereumTransactionTypeDynamicFee:
+		return "DYNAMIC_FEE"
+	}
+
+	return fmt.Sprintf("EthereumTransactionType(%d)", uint8(txType))
+}
+
+// EthereumAccessTuple is an entry of the access list of an EIP-2930 or EIP-1559 transaction
+type EthereumAccessTuple struct {
+	Address     []byte
+	StorageKeys [][]byte
+}
+
+// EthereumTransactionBuilder builds and signs raw Ethereum transactions with an ECDSA secp256k1 PrivateKey, producing
+// the bytes EthereumTransaction.SetEthereumData and EthereumFlow.SetEthereumDataBytes take.
+// Values and gas prices are in weibars, where one tinybar is 10^10 weibars.
+type EthereumTransactionBuilder struct {
+	txType               EthereumTransactionType
+	chainID              uint64
+	nonce                uint64
+	gasLimit             uint64
+	gasPrice             *big.Int
+	maxPriorityFeePerGas *big.Int
+	maxFeePerGas         *big.Int
+	to                   []byte
+	value                *big.Int
+	data                 []byte
+	accessList           []EthereumAccessTuple
+}
+
+// NewEthereumTransactionBuilder creates a builder of transactions of the type for the chain of testnet
+func NewEthereumTransactionBuilder(txType EthereumTransactionType) *EthereumTransactionBuilder {
+	return &EthereumTransactionBuilder{
+		txType:  txType,
+		chainID: EthereumChainIDTestnet,
+	}
+}
+
+// SetChainID sets the chain ID the transaction is signed for, such as EthereumChainIDMainnet
+func (builder *EthereumTransactionBuilder) SetChainID(chainID uint64) *EthereumTransactionBuilder {
+	builder.chainID = chainID
+	return builder
+}
+
+// GetChainID returns the chain ID the transaction is signed for
+func (builder *EthereumTransactionBuilder) GetChainID() uint64 {
+	return builder.chainID
+}
+
+// GetType returns the type of the transaction
+func (builder *EthereumTransactionBuilder) GetType() EthereumTransactionType {
+	return builder.txType
+}
+
+// SetNonce sets the nonce of the transaction, which is the ethereum nonce of the signing account
+func (builder *EthereumTransactionBuilder) SetNonce(nonce uint64) *EthereumTransactionBuilder {
+	builder.nonce = nonce
+	return builder
+}
+
+// GetNonce returns the nonce of the transaction
+func (builder *EthereumTransactionBuilder) GetNonce() uint64 {
+	return builder.nonce
+}
+
+// SetGasLimit sets the gas limit of the transaction
+func (builder *EthereumTransactionBuilder) SetGasLimit(gasLimit uint64) *EthereumTransactionBuilder {
+	builder.gasLimit = gasLimit
+	return builder
+}
+
+// GetGasLimit returns the gas limit of the transaction
+func (builder *EthereumTransactionBuilder) GetGasLimit() uint64 {
+	return builder.gasLimit
+}
+
+// SetGasPrice sets the gas price in weibars of a legacy or access list transaction
+func (builder *EthereumTransactionBuilder) SetGasPrice(gasPrice *big.Int) *EthereumTransactionBuilder {
+	builder.gasPrice = gasPrice
+	return builder
+}
+
+// GetGasPrice returns the gas price of a legacy or access list transaction
+func (builder *EthereumTransactionBuilder) GetGasPrice() *big.Int {
+	return builder.gasPrice
+}
+
+// SetMaxPriorityFeePerGas sets the tip in weibars per gas of a dynamic fee transaction
+func (builder *EthereumTransactionBuilder) SetMaxPriorityFeePerGas(tip *big.Int) *EthereumTransactionBuilder {
+	builder.maxPriorityFeePerGas = tip
+	return builder
+}
+
+// GetMaxPriorityFeePerGas returns the tip per gas of a dynamic fee transaction
+func (builder *EthereumTransactionBuilder) GetMaxPriorityFeePerGas() *big.Int {
+	return builder.maxPriorityFeePerGas
+}
+
+// SetMaxFeePerGas sets the maximum fee in weibars per gas of a dynamic fee transaction
+func (builder *EthereumTransactionBuilder) SetMaxFeePerGas(maxFee *big.Int) *EthereumTransactionBuilder {
+	builder.maxFeePerGas = maxFee
+	return builder
+}
+
+// GetMaxFeePerGas returns the maximum fee per gas of a dynamic fee transaction
+func (builder *EthereumTransactionBuilder) GetMaxFeePerGas() *big.Int {
+	return builder.maxFeePerGas
+}
+
+// SetTo sets the 20 byte EVM address the transaction calls. Without one the transaction creates a contract.
+func (builder *EthereumTransactionBuilder) SetTo(address []byte) *EthereumTransactionBuilder {
+	builder.to = address
+	return builder
+}
+
+// SetToContractID sets the contract the transaction calls
+func (builder *EthereumTransactionBuilder) SetToContractID(contractID ContractID) *EthereumTransactionBuilder {
+	builder.to = _ContractEvmAddress(contractID)
+	return builder
+}
+
+// GetTo returns the EVM address the transaction calls, nil for a contract creation
+func (builder *EthereumTransactionBuilder) GetTo() []byte {
+	return builder.to
+}
+
+// SetValue sets the value in weibars the transaction transfers
+func (builder *EthereumTransactionBuilder) SetValue(value *big.Int) *EthereumTransactionBuilder {
+	builder.value = value
+	return builder
+}
+
+// SetValueHbar sets the value the transaction transfers
+func (builder *EthereumTransactionBuilder) SetValueHbar(value Hbar) *EthereumTransactionBuilder {
+	builder.value = new(big.Int).Mul(big.NewInt(value.AsTinybar()), big.NewInt(10_000_000_000))
+	return builder
+}
+
+// GetValue returns the value in weibars the transaction transfers
+func (builder *EthereumTransactionBuilder) GetValue() *big.Int {
+	return builder.value
+}
+
+// SetData sets the call data of the transaction, or the init code of the contract it creates
+func (builder *EthereumTransactionBuilder) SetData(data []byte) *EthereumTransactionBuilder {
+	builder.data = data
+	return builder
+}
+
+// GetData returns the call data of the transaction
+func (builder *EthereumTransactionBuilder) GetData() []byte {
+	return builder.data
+}
+
+// AddAccessTuple adds an entry to the access list of an access list or dynamic fee transaction
+func (builder *EthereumTransactionBuilder) AddAccessTuple(tuple EthereumAccessTuple) *EthereumTransactionBuilder {
+	builder.accessList = append(builder.accessList, tuple)
+	return builder
+}
+
+// GetAccessList returns the access list of the transaction
+func (builder *EthereumTransactionBuilder) GetAccessList() []EthereumAccessTuple {
+	return builder.accessList
+}
+
+// Sign signs the transaction with the ECDSA secp256k1 key and returns its raw bytes
+func (builder *EthereumTransactionBuilder) Sign(key PrivateKey) ([]byte, error) {
+	if key.ecdsaPrivateKey == nil {
+		return nil, errors.New("ethereum transactions can only be signed with ECDSA secp256k1 keys")
+	}
+
+	inner, err := builder._Build()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := new(big.Int).SetUint64(builder.chainID)
+	signed, err := types.SignNewTx(key.ecdsaPrivateKey.keyData, types.LatestSignerForChainID(chainID), inner)
+	if err != nil {
+		return nil, err
+	}
+
+	return signed.MarshalBinary()
+}
+
+// SignData signs the transaction like Sign and returns it as EthereumTransactionData
+func (builder *EthereumTransactionBuilder) SignData(key PrivateKey) (*EthereumTransactionData, error) {
+	data, err := builder.Sign(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return EthereumTransactionDataFromBytes(data)
+}
+
+func (builder *EthereumTransactionBuilder) _Build() (types.TxData, error) {
+	var to *common.Address
+	if builder.to != nil {
+		if len(builder.to) != common.AddressLength {
+			return nil, fmt.Errorf("to address is %d bytes instead of 20", len(builder.to))
+		}
+		address := common.BytesToAddress(builder.to)
+		to = &address
+	}
+
+	value := builder.value
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	accessList := make(types.AccessList, len(builder.accessList))
+	for i, tuple := range builder.accessList {
+		if len(tuple.Address) != common.AddressLength {
+			return nil, fmt.Errorf("access list address is %d bytes instead of 20", len(tuple.Address))
+		}
+		accessList[i] = types.AccessTuple{Address: common.BytesToAddress(tuple.Address), StorageKeys: make([]common.Hash, len(tuple.StorageKeys))}
+		for j, key := range tuple.StorageKeys {
+			if len(key) > common.HashLength {
+				return nil, fmt.Errorf("storage key is %d bytes instead of 32", len(key))
+			}
+			accessList[i].StorageKeys[j] = common.BytesToHash(key)
+		}
+	}
+
+	switch builder.txType {
+	case EthereumTransactionTypeLegacy:
+		if len(accessList) > 0 {
+			return nil, errors.New("legacy transactions have no access list")
+		}
+		return &types.LegacyTx{
+			Nonce:    builder.nonce,
+			GasPrice: _BigIntOrZero(builder.gasPrice),
+			Gas:      builder.gasLimit,
+			To:       to,
+			Value:    value,
+			Data:     builder.data,
+		}, nil
+	case EthereumTransactionTypeAccessList:
+		return &types.AccessListTx{
+			ChainID:    new(big.Int).SetUint64(builder.chainID),
+			Nonce:      builder.nonce,
+			GasPrice:   _BigIntOrZero(builder.gasPrice),
+			Gas:        builder.gasLimit,
+			To:         to,
+			Value:      value,
+			Data:       builder.data,
+			AccessList: accessList,
+		}, nil
+	case EthereumTransactionTypeDynamicFee:
+		return &types.DynamicFeeTx{
+			ChainID:    new(big.Int).SetUint64(builder.chainID),
+			Nonce:      builder.nonce,
+			GasTipCap:  _BigIntOrZero(builder.maxPriorityFeePerGas),
+			GasFeeCap:  _BigIntOrZero(builder.maxFeePerGas),
+			Gas:        builder.gasLimit,
+			To:         to,
+			Value:      value,
+			Data:       builder.data,
+			AccessList: accessList,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported ethereum transaction type %d", uint8(builder.txType))
+}
+
+func _BigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+
+	return value
+}
+
+// _ContractEvmAddress returns the EVM address of a contract: the one it is named by, or else the long-zero
+// address of its number
+func _ContractEvmAddress(contractID ContractID) []byte {
+	if contractID.EvmAddress != nil {
+		return contractID.EvmAddress
+	}
+
+	address, _ := hex.DecodeString(contractID.ToSolidityAddress())
+	return address
+}
@@ -0,0 +1,169 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestSignedEthereumTransaction(t *testing.T, key PrivateKey, builder *EthereumTransactionBuilder) *types.Transaction {
+	data, err := builder.Sign(key)
+	require.NoError(t, err)
+
+	var tx types.Transaction
+	require.NoError(t, tx.UnmarshalBinary(data))
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), &tx)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey().ToEvmAddress(), hex.EncodeToString(sender.Bytes()))
+
+	return &tx
+}
+
+func TestUnitEthereumTransactionBuilderLegacy(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	to, err := hex.DecodeString("7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181")
+	require.NoError(t, err)
+
+	tx := _TestSignedEthereumTransaction(t, key, NewEthereumTransactionBuilder(EthereumTransactionTypeLegacy).
+		SetChainID(EthereumChainIDMainnet).
+		SetNonce(3).
+		SetGasLimit(100_000).
+		SetGasPrice(big.NewInt(710_000_000_000)).
+		SetTo(to).
+		SetValueHbar(NewHbar(1)).
+		SetData([]byte{0x12, 0x34}))
+
+	require.Equal(t, uint8(types.LegacyTxType), tx.Type())
+	require.Equal(t, big.NewInt(295), tx.ChainId())
+	require.Equal(t, uint64(3), tx.Nonce())
+	require.Equal(t, uint64(100_000), tx.Gas())
+	require.Equal(t, big.NewInt(710_000_000_000), tx.GasPrice())
+	require.Equal(t, to, tx.To().Bytes())
+	require.Equal(t, new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil), tx.Value())
+	require.Equal(t, []byte{0x12, 0x34}, tx.Data())
+}
+
+func TestUnitEthereumTransactionBuilderAccessList(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	builder := NewEthereumTransactionBuilder(EthereumTransactionTypeAccessList).
+		SetNonce(1).
+		SetGasLimit(50_000).
+		SetGasPrice(big.NewInt(1)).
+		SetToContractID(ContractID{Contract: 1234}).
+		AddAccessTuple(EthereumAccessTuple{Address: make([]byte, 20), StorageKeys: [][]byte{{0x01}}})
+
+	tx := _TestSignedEthereumTransaction(t, key, builder)
+	require.Equal(t, uint8(types.AccessListTxType), tx.Type())
+	require.Equal(t, big.NewInt(296), tx.ChainId())
+	require.Equal(t, "00000000000000000000000000000000000004d2", hex.EncodeToString(tx.To().Bytes()))
+	require.Len(t, tx.AccessList(), 1)
+	require.Equal(t, byte(0x01), tx.AccessList()[0].StorageKeys[0][31])
+
+	data, err := builder.SignData(key)
+	require.NoError(t, err)
+	raw, err := data.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, byte(types.AccessListTxType), raw[0])
+
+	var decoded types.Transaction
+	require.NoError(t, decoded.UnmarshalBinary(raw))
+	require.Equal(t, tx.Nonce(), decoded.Nonce())
+}
+
+func TestUnitEthereumTransactionBuilderDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	builder := NewEthereumTransactionBuilder(EthereumTransactionTypeDynamicFee).
+		SetChainID(EthereumChainIDPreviewnet).
+		SetGasLimit(1_000_000).
+		SetMaxPriorityFeePerGas(big.NewInt(2)).
+		SetMaxFeePerGas(big.NewInt(1_000_000_000_000)).
+		SetData([]byte{0x60, 0x80})
+
+	tx := _TestSignedEthereumTransaction(t, key, builder)
+	require.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+	require.Equal(t, big.NewInt(297), tx.ChainId())
+	require.Nil(t, tx.To())
+	require.Equal(t, big.NewInt(2), tx.GasTipCap())
+	require.Equal(t, big.NewInt(1_000_000_000_000), tx.GasFeeCap())
+
+	data, err := builder.SignData(key)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80}, data._GetData())
+}
+
+func TestUnitEthereumTransactionBuilderErrors(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = NewEthereumTransactionBuilder(EthereumTransactionTypeLegacy).Sign(ed25519Key)
+	require.ErrorContains(t, err, "ECDSA")
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	_, err = NewEthereumTransactionBuilder(EthereumTransactionTypeLegacy).SetTo([]byte{1, 2, 3}).Sign(key)
+	require.ErrorContains(t, err, "3 bytes")
+
+	_, err = NewEthereumTransactionBuilder(EthereumTransactionTypeLegacy).
+		AddAccessTuple(EthereumAccessTuple{Address: make([]byte, 20)}).
+		Sign(key)
+	require.ErrorContains(t, err, "no access list")
+
+	_, err = NewEthereumTransactionBuilder(EthereumTransactionType(9)).Sign(key)
+	require.ErrorContains(t, err, "unsupported")
+}
+
+func TestUnitEthereumChainIDForLedgerID(t *testing.T) {
+	t.Parallel()
+
+	chainID, err := EthereumChainIDForLedgerID(NewLedgerIDMainnet())
+	require.NoError(t, err)
+	require.Equal(t, EthereumChainIDMainnet, chainID)
+
+	chainID, err = EthereumChainIDForLedgerID(NewLedgerIDPreviewnet())
+	require.NoError(t, err)
+	require.Equal(t, EthereumChainIDPreviewnet, chainID)
+
+	_, err = EthereumChainIDForLedgerID(LedgerIDFromBytes([]byte{7}))
+	require.Error(t, err)
+}
@@ -32,6 +32,7 @@ import (
 // Represents the data of an Ethereum transaction.
 type EthereumTransactionData struct {
 	eip1559 *types.DynamicFeeTx
+	eip2930 *types.AccessListTx
 	legacy  *types.LegacyTx
 }
 
@@ -49,6 +50,15 @@ func EthereumTransactionDataFromBytes(b []byte) (*EthereumTransactionData, error
 		return &transactionData, nil
 	}
 
+	if b[0] == 1 {
+		err := rlp.DecodeBytes(b[1:], &transactionData.eip2930)
+		if err != nil {
+			return nil, err
+		}
+
+		return &transactionData, nil
+	}
+
 	err := rlp.DecodeBytes(b, &transactionData.legacy)
 	if err != nil {
 		return nil, err
@@ -71,6 +81,15 @@ func (ethereumTxData *EthereumTransactionData) ToBytes() ([]byte, error) {
 		return byt, nil
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		byt, err = rlp.EncodeToBytes(ethereumTxData.eip2930)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		return append([]byte{1}, byt...), nil
+	}
+
 	byt, err = rlp.EncodeToBytes(ethereumTxData.legacy)
 	if err != nil {
 		return []byte{}, err
@@ -84,6 +103,10 @@ func (ethereumTxData *EthereumTransactionData) _GetData() []byte {
 		return ethereumTxData.eip1559.Data
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		return ethereumTxData.eip2930.Data
+	}
+
 	return ethereumTxData.legacy.Data
 }
 
@@ -93,6 +116,11 @@ func (ethereumTxData *EthereumTransactionData) _SetData(data []byte) *EthereumTr
 		return ethereumTxData
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		ethereumTxData.eip2930.Data = data
+		return ethereumTxData
+	}
+
 	ethereumTxData.legacy.Data = data
 	return ethereumTxData
 }
@@ -110,6 +138,10 @@ func (ethereumTxData *EthereumTransactionData) ToJson() ([]byte, error) {
 		return byt, nil
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		return json.Marshal(ethereumTxData.eip2930)
+	}
+
 	byt, err = json.Marshal(ethereumTxData.legacy)
 	if err != nil {
 		return []byte{}, err
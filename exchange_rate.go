@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	protobuf "google.golang.org/protobuf/proto"
@@ -58,6 +59,29 @@ func (exchange *ExchangeRate) _ToProtobuf() *services.ExchangeRate {
 	}
 }
 
+// NewExchangeRate creates an ExchangeRate where hbars are worth cents US cents until expirationTime
+func NewExchangeRate(hbars int32, cents int32, expirationTime time.Time) ExchangeRate {
+	return ExchangeRate{
+		Hbars:          hbars,
+		cents:          cents,
+		expirationTime: &services.TimestampSeconds{Seconds: expirationTime.Unix()},
+	}
+}
+
+// GetCents returns the US cents Hbars hbar are worth
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
+// GetExpirationTime returns the time the exchange rate expires
+func (exchange *ExchangeRate) GetExpirationTime() time.Time {
+	if exchange.expirationTime == nil {
+		return time.Time{}
+	}
+
+	return time.Unix(exchange.expirationTime.Seconds, 0)
+}
+
 // ToBytes returns the byte representation of the ExchangeRate
 func (exchange *ExchangeRate) ToBytes() []byte {
 	data, err := protobuf.Marshal(exchange._ToProtobuf())
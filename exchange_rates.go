@@ -0,0 +1,82 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ExchangeRates is the current and the next exchange rate between HBAR and USD, as stored in the
+// exchange rate system file 0.0.112
+type ExchangeRates struct {
+	Current ExchangeRate
+	Next    ExchangeRate
+}
+
+func _ExchangeRatesFromProtobuf(pb *services.ExchangeRateSet) ExchangeRates {
+	if pb == nil {
+		return ExchangeRates{}
+	}
+
+	return ExchangeRates{
+		Current: _ExchangeRateFromProtobuf(pb.GetCurrentRate()),
+		Next:    _ExchangeRateFromProtobuf(pb.GetNextRate()),
+	}
+}
+
+func (rates ExchangeRates) _ToProtobuf() *services.ExchangeRateSet {
+	return &services.ExchangeRateSet{
+		CurrentRate: rates.Current._ToProtobuf(),
+		NextRate:    rates.Next._ToProtobuf(),
+	}
+}
+
+// ToBytes returns the byte representation of the ExchangeRates
+func (rates ExchangeRates) ToBytes() []byte {
+	data, err := protobuf.Marshal(rates._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// ExchangeRatesFromBytes returns ExchangeRates from the contents of the exchange rate system file
+func ExchangeRatesFromBytes(data []byte) (ExchangeRates, error) {
+	if data == nil {
+		return ExchangeRates{}, errByteArrayNull
+	}
+	pb := services.ExchangeRateSet{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	return _ExchangeRatesFromProtobuf(&pb), nil
+}
+
+// String returns a string representation of the ExchangeRates
+func (rates ExchangeRates) String() string {
+	return fmt.Sprintf("Current: %s, Next: %s", rates.Current.String(), rates.Next.String())
+}
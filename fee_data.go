@@ -31,6 +31,8 @@ type FeeData struct {
 	NodeData    *FeeComponents
 	NetworkData *FeeComponents
 	ServiceData *FeeComponents
+	// The kind of transaction these prices apply to
+	SubType FeeDataType
 }
 
 func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
@@ -57,6 +59,7 @@ func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
 		NodeData:    &nodeData,
 		NetworkData: &networkData,
 		ServiceData: &serviceData,
+		SubType:     FeeDataType(feeData.GetSubType()),
 	}, nil
 }
 
@@ -80,6 +83,7 @@ func (feeData FeeData) _ToProtobuf() *services.FeeData {
 		Nodedata:    nodeData,
 		Networkdata: networkData,
 		Servicedata: serviceData,
+		SubType:     services.SubType(feeData.SubType),
 	}
 }
 
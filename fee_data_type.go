@@ -0,0 +1,56 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import "fmt"
+
+// FeeDataType distinguishes the prices a fee schedule lists for different kinds of the same transaction,
+// for example fungible and non-fungible token transfers.
+type FeeDataType int32
+
+const (
+	FeeDataTypeDefault                              FeeDataType = 0
+	FeeDataTypeTokenFungibleCommon                  FeeDataType = 1
+	FeeDataTypeTokenNonFungibleUnique               FeeDataType = 2
+	FeeDataTypeTokenFungibleCommonWithCustomFees    FeeDataType = 3
+	FeeDataTypeTokenNonFungibleUniqueWithCustomFees FeeDataType = 4
+	FeeDataTypeScheduleCreateContractCall           FeeDataType = 5
+)
+
+// String returns a string representation of the FeeDataType
+func (feeDataType FeeDataType) String() string {
+	switch feeDataType {
+	case FeeDataTypeDefault:
+		return "DEFAULT"
+	case FeeDataTypeTokenFungibleCommon:
+		return "TOKEN_FUNGIBLE_COMMON"
+	case FeeDataTypeTokenNonFungibleUnique:
+		return "TOKEN_NON_FUNGIBLE_UNIQUE"
+	case FeeDataTypeTokenFungibleCommonWithCustomFees:
+		return "TOKEN_FUNGIBLE_COMMON_WITH_CUSTOM_FEES"
+	case FeeDataTypeTokenNonFungibleUniqueWithCustomFees:
+		return "TOKEN_NON_FUNGIBLE_UNIQUE_WITH_CUSTOM_FEES"
+	case FeeDataTypeScheduleCreateContractCall:
+		return "SCHEDULE_CREATE_CONTRACT_CALL"
+	}
+
+	return fmt.Sprintf("UNKNOWN_FEE_DATA_TYPE(%d)", int32(feeDataType))
+}
@@ -0,0 +1,413 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Constants the network uses when turning resource usage into fees
+const (
+	// Fee schedule prices are in thousandths of a tinycent
+	feeDivisorFactor = 1000
+	// Tinycents in a US cent
+	tinycentsPerCent = 100_000_000
+
+	feeReceiptStorageSeconds = 180
+	feeBasicReceiptBytes     = 4 + 3*8 + 32
+	feeBasicAccountBytes     = 128
+	feeBasicTopicBytes       = 100
+	feeBasicTokenBytes       = 150
+	feeScheduleLifetime      = 1800
+	// Used for storage whose lifetime isn't part of the transaction, 90 days
+	feeDefaultLifetimeSeconds = 7776000
+)
+
+// FeeEstimate is the fee a transaction is expected to be charged, split into the three components the
+// network charges: the node fee paid to the submitting node, the network fee for consensus and the
+// service fee for the state the transaction changes.
+type FeeEstimate struct {
+	RequestType RequestType
+	SubType     FeeDataType
+
+	NodeFee    Hbar
+	NetworkFee Hbar
+	ServiceFee Hbar
+	TotalFee   Hbar
+
+	// The same fees in tinycents, 1/100,000,000 of a US cent
+	NodeTinycents    int64
+	NetworkTinycents int64
+	ServiceTinycents int64
+}
+
+// GetTotalUsdCents returns the total fee in US cents
+func (estimate FeeEstimate) GetTotalUsdCents() float64 {
+	return float64(estimate.NodeTinycents+estimate.NetworkTinycents+estimate.ServiceTinycents) / tinycentsPerCent
+}
+
+// String returns a string representation of the FeeEstimate
+func (estimate FeeEstimate) String() string {
+	return fmt.Sprintf("node: %s, network: %s, service: %s, total: %s (%.4f USD cents)",
+		estimate.NodeFee.String(), estimate.NetworkFee.String(), estimate.ServiceFee.String(),
+		estimate.TotalFee.String(), estimate.GetTotalUsdCents())
+}
+
+type _FeeEstimatable interface {
+	TransactionInterface
+	estimateSize(TransactionInterface, int) (int, error)
+	_ProjectedSignatureCount() int
+}
+
+// EstimateFee estimates the fee the network will charge for tx using the fee schedules and exchange rate
+// published in the system files 0.0.111 and 0.0.112. The usage of the transaction is derived from its
+// serialized size, its expected signature count (at least one, plus a signature for every key it has been
+// signed with) and the storage it creates, following the network's own usage estimates as closely as they
+// can be reproduced without access to state. Congestion pricing is not taken into account.
+func EstimateFee(tx TransactionInterface, feeSchedules FeeSchedules, exchangeRate ExchangeRate) (FeeEstimate, error) {
+	estimatable, ok := tx.(_FeeEstimatable)
+	if !ok {
+		return FeeEstimate{}, fmt.Errorf("fee estimation is not supported for %T", tx)
+	}
+
+	if exchangeRate.cents == 0 {
+		return FeeEstimate{}, fmt.Errorf("exchange rate has no cent equivalent")
+	}
+
+	signatureCount := estimatable._ProjectedSignatureCount()
+	size, err := estimatable.estimateSize(tx, signatureCount)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	body := tx.build()
+	requestType, subType, serviceUsage := _FeeServiceUsage(body)
+
+	prices, err := _FeePrices(feeSchedules, requestType, subType)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	nodeUsage := FeeComponents{
+		Constant:                 1,
+		TransactionBandwidthByte: int64(size),
+		TransactionVerification:  1,
+		ResponseMemoryByte:       4,
+	}
+	networkUsage := FeeComponents{
+		Constant:                 1,
+		TransactionBandwidthByte: int64(size),
+		TransactionVerification:  int64(signatureCount),
+		TransactionRamByteHour:   _FeeByteHours(feeBasicReceiptBytes, feeReceiptStorageSeconds),
+	}
+
+	estimate := FeeEstimate{
+		RequestType:      requestType,
+		SubType:          subType,
+		NodeTinycents:    _FeeComponentTinycents(prices.NodeData, nodeUsage),
+		NetworkTinycents: _FeeComponentTinycents(prices.NetworkData, networkUsage),
+		ServiceTinycents: _FeeComponentTinycents(prices.ServiceData, serviceUsage),
+	}
+
+	estimate.NodeFee = _FeeTinycentsToHbar(estimate.NodeTinycents, exchangeRate)
+	estimate.NetworkFee = _FeeTinycentsToHbar(estimate.NetworkTinycents, exchangeRate)
+	estimate.ServiceFee = _FeeTinycentsToHbar(estimate.ServiceTinycents, exchangeRate)
+	estimate.TotalFee = HbarFromTinybar(estimate.NodeFee.AsTinybar() + estimate.NetworkFee.AsTinybar() + estimate.ServiceFee.AsTinybar())
+
+	return estimate, nil
+}
+
+// _FeePrices finds the prices for the request type in the schedule in effect now, preferring the ones
+// for the exact sub type and falling back to the default ones.
+func _FeePrices(feeSchedules FeeSchedules, requestType RequestType, subType FeeDataType) (*FeeData, error) {
+	schedule := feeSchedules.current
+	if schedule == nil || (schedule.ExpirationTime != nil && !schedule.ExpirationTime.IsZero() &&
+		schedule.ExpirationTime.Before(time.Now()) && feeSchedules.next != nil) {
+		schedule = feeSchedules.next
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("fee schedules contain no schedule")
+	}
+
+	for _, txFeeSchedule := range schedule.TransactionFeeSchedules {
+		if txFeeSchedule.RequestType != requestType {
+			continue
+		}
+
+		var fallback *FeeData
+		for _, fees := range txFeeSchedule.Fees {
+			if fees.SubType == subType {
+				return fees, nil
+			}
+			if fees.SubType == FeeDataTypeDefault {
+				fallback = fees
+			}
+		}
+		if fallback == nil {
+			fallback = txFeeSchedule.FeeData
+		}
+		if fallback != nil {
+			return fallback, nil
+		}
+	}
+
+	return nil, fmt.Errorf("fee schedule has no prices for %s", services.HederaFunctionality(requestType).String())
+}
+
+// _FeeComponentTinycents prices the usage with the component's prices the same way the network does,
+// clamping to the component's min and max
+func _FeeComponentTinycents(price *FeeComponents, usage FeeComponents) int64 {
+	if price == nil {
+		return 0
+	}
+
+	total := price.Constant*usage.Constant +
+		price.TransactionBandwidthByte*usage.TransactionBandwidthByte +
+		price.TransactionVerification*usage.TransactionVerification +
+		price.TransactionRamByteHour*usage.TransactionRamByteHour +
+		price.TransactionStorageByteHour*usage.TransactionStorageByteHour +
+		price.ContractTransactionGas*usage.ContractTransactionGas +
+		price.TransferVolumeHbar*usage.TransferVolumeHbar +
+		price.ResponseMemoryByte*usage.ResponseMemoryByte +
+		price.ResponseDiscByte*usage.ResponseDiscByte
+
+	if total < price.Min {
+		total = price.Min
+	} else if price.Max > 0 && total > price.Max {
+		total = price.Max
+	}
+
+	if total > 0 && total < feeDivisorFactor {
+		return 1
+	}
+
+	return total / feeDivisorFactor
+}
+
+func _FeeTinycentsToHbar(tinycents int64, exchangeRate ExchangeRate) Hbar {
+	return HbarFromTinybar(tinycents * int64(exchangeRate.Hbars) / int64(exchangeRate.cents))
+}
+
+// _FeeByteHours converts bytes stored for a number of seconds into byte hours, rounding up
+func _FeeByteHours(bytes int64, seconds int64) int64 {
+	if bytes <= 0 || seconds <= 0 {
+		return 0
+	}
+
+	return (bytes*seconds + 3599) / 3600
+}
+
+func _FeeKeyBytes(key *services.Key) int64 {
+	if key == nil {
+		return 0
+	}
+
+	return int64(protobuf.Size(key))
+}
+
+func _FeeLifetime(expiration *services.Timestamp) int64 {
+	if expiration == nil {
+		return feeDefaultLifetimeSeconds
+	}
+
+	lifetime := expiration.GetSeconds() - time.Now().Unix()
+	if lifetime <= 0 {
+		return feeDefaultLifetimeSeconds
+	}
+
+	return lifetime
+}
+
+// _FeeServiceUsage returns the request type, the sub type and the service usage of a transaction body
+func _FeeServiceUsage(body *services.TransactionBody) (RequestType, FeeDataType, FeeComponents) {
+	usage := FeeComponents{Constant: 1}
+
+	switch data := body.GetData().(type) {
+	case *services.TransactionBody_CryptoTransfer:
+		subType := FeeDataTypeDefault
+		for _, list := range data.CryptoTransfer.GetTokenTransfers() {
+			if len(list.GetNftTransfers()) > 0 {
+				subType = FeeDataTypeTokenNonFungibleUnique
+			} else if subType == FeeDataTypeDefault {
+				subType = FeeDataTypeTokenFungibleCommon
+			}
+		}
+		return RequestTypeCryptoTransfer, subType, usage
+	case *services.TransactionBody_CryptoCreateAccount:
+		create := data.CryptoCreateAccount
+		bytes := feeBasicAccountBytes + _FeeKeyBytes(create.GetKey()) + int64(len(create.GetMemo()))
+		usage.TransactionRamByteHour = _FeeByteHours(bytes, create.GetAutoRenewPeriod().GetSeconds())
+		return RequestTypeCryptoCreate, FeeDataTypeDefault, usage
+	case *services.TransactionBody_CryptoUpdateAccount:
+		update := data.CryptoUpdateAccount
+		bytes := _FeeKeyBytes(update.GetKey()) + int64(len(update.GetMemo().GetValue()))
+		usage.TransactionRamByteHour = _FeeByteHours(bytes, update.GetAutoRenewPeriod().GetSeconds())
+		return RequestTypeCryptoUpdate, FeeDataTypeDefault, usage
+	case *services.TransactionBody_FileCreate:
+		create := data.FileCreate
+		bytes := int64(len(create.GetContents())) + int64(protobuf.Size(create.GetKeys()))
+		usage.TransactionStorageByteHour = _FeeByteHours(bytes, _FeeLifetime(create.GetExpirationTime()))
+		return RequestTypeFileCreate, FeeDataTypeDefault, usage
+	case *services.TransactionBody_FileAppend:
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(len(data.FileAppend.GetContents())), feeDefaultLifetimeSeconds)
+		return RequestTypeFileAppend, FeeDataTypeDefault, usage
+	case *services.TransactionBody_FileUpdate:
+		update := data.FileUpdate
+		bytes := int64(len(update.GetContents())) + int64(protobuf.Size(update.GetKeys()))
+		usage.TransactionStorageByteHour = _FeeByteHours(bytes, _FeeLifetime(update.GetExpirationTime()))
+		return RequestTypeFileUpdate, FeeDataTypeDefault, usage
+	case *services.TransactionBody_ContractCall:
+		usage.ContractTransactionGas = data.ContractCall.GetGas()
+		return RequestTypeContractCall, FeeDataTypeDefault, usage
+	case *services.TransactionBody_ContractCreateInstance:
+		create := data.ContractCreateInstance
+		usage.ContractTransactionGas = create.GetGas()
+		bytes := feeBasicAccountBytes + _FeeKeyBytes(create.GetAdminKey()) + int64(len(create.GetMemo()))
+		usage.TransactionRamByteHour = _FeeByteHours(bytes, create.GetAutoRenewPeriod().GetSeconds())
+		return RequestTypeContractCreate, FeeDataTypeDefault, usage
+	case *services.TransactionBody_ConsensusCreateTopic:
+		create := data.ConsensusCreateTopic
+		bytes := feeBasicTopicBytes + _FeeKeyBytes(create.GetAdminKey()) + _FeeKeyBytes(create.GetSubmitKey()) +
+			int64(len(create.GetMemo()))
+		usage.TransactionRamByteHour = _FeeByteHours(bytes, create.GetAutoRenewPeriod().GetSeconds())
+		return RequestTypeConsensusCreateTopic, FeeDataTypeDefault, usage
+	case *services.TransactionBody_TokenCreation:
+		create := data.TokenCreation
+		subType := FeeDataTypeTokenFungibleCommon
+		if create.GetTokenType() == services.TokenType_NON_FUNGIBLE_UNIQUE {
+			subType = FeeDataTypeTokenNonFungibleUnique
+		}
+		if len(create.GetCustomFees()) > 0 {
+			subType += FeeDataTypeTokenFungibleCommonWithCustomFees - FeeDataTypeTokenFungibleCommon
+		}
+		bytes := feeBasicTokenBytes + int64(len(create.GetName())+len(create.GetSymbol())+len(create.GetMemo())) +
+			_FeeKeyBytes(create.GetAdminKey()) + _FeeKeyBytes(create.GetKycKey()) + _FeeKeyBytes(create.GetFreezeKey()) +
+			_FeeKeyBytes(create.GetWipeKey()) + _FeeKeyBytes(create.GetSupplyKey()) + _FeeKeyBytes(create.GetFeeScheduleKey()) +
+			_FeeKeyBytes(create.GetPauseKey()) + _FeeKeyBytes(create.GetMetadataKey()) + int64(len(create.GetMetadata()))
+		lifetime := create.GetAutoRenewPeriod().GetSeconds()
+		if lifetime == 0 {
+			lifetime = _FeeLifetime(create.GetExpiry())
+		}
+		usage.TransactionRamByteHour = _FeeByteHours(bytes, lifetime)
+		return RequestTypeTokenCreate, subType, usage
+	case *services.TransactionBody_TokenMint:
+		if len(data.TokenMint.GetMetadata()) > 0 {
+			bytes := int64(0)
+			for _, metadata := range data.TokenMint.GetMetadata() {
+				bytes += int64(len(metadata))
+			}
+			usage.TransactionRamByteHour = _FeeByteHours(bytes, feeDefaultLifetimeSeconds)
+			return RequestTypeTokenMint, FeeDataTypeTokenNonFungibleUnique, usage
+		}
+		return RequestTypeTokenMint, FeeDataTypeTokenFungibleCommon, usage
+	case *services.TransactionBody_TokenBurn:
+		if len(data.TokenBurn.GetSerialNumbers()) > 0 {
+			return RequestTypeTokenBurn, FeeDataTypeTokenNonFungibleUnique, usage
+		}
+		return RequestTypeTokenBurn, FeeDataTypeTokenFungibleCommon, usage
+	case *services.TransactionBody_TokenWipe:
+		if len(data.TokenWipe.GetSerialNumbers()) > 0 {
+			return RequestTypeTokenAccountWipe, FeeDataTypeTokenNonFungibleUnique, usage
+		}
+		return RequestTypeTokenAccountWipe, FeeDataTypeTokenFungibleCommon, usage
+	case *services.TransactionBody_ScheduleCreate:
+		subType := FeeDataTypeDefault
+		if data.ScheduleCreate.GetScheduledTransactionBody().GetContractCall() != nil {
+			subType = FeeDataTypeScheduleCreateContractCall
+		}
+		usage.TransactionRamByteHour = _FeeByteHours(int64(protobuf.Size(data.ScheduleCreate)), feeScheduleLifetime)
+		return RequestTypeScheduleCreate, subType, usage
+	}
+
+	return RequestType(_FeeFunctionality(body)), FeeDataTypeDefault, usage
+}
+
+// _FeeFunctionality maps the transaction bodies without special usage to the functionality they are priced as
+func _FeeFunctionality(body *services.TransactionBody) services.HederaFunctionality {
+	switch body.GetData().(type) {
+	case *services.TransactionBody_CryptoDelete:
+		return services.HederaFunctionality_CryptoDelete
+	case *services.TransactionBody_CryptoApproveAllowance:
+		return services.HederaFunctionality_CryptoApproveAllowance
+	case *services.TransactionBody_CryptoDeleteAllowance:
+		return services.HederaFunctionality_CryptoDeleteAllowance
+	case *services.TransactionBody_CryptoAddLiveHash:
+		return services.HederaFunctionality_CryptoAddLiveHash
+	case *services.TransactionBody_CryptoDeleteLiveHash:
+		return services.HederaFunctionality_CryptoDeleteLiveHash
+	case *services.TransactionBody_ContractUpdateInstance:
+		return services.HederaFunctionality_ContractUpdate
+	case *services.TransactionBody_ContractDeleteInstance:
+		return services.HederaFunctionality_ContractDelete
+	case *services.TransactionBody_EthereumTransaction:
+		return services.HederaFunctionality_EthereumTransaction
+	case *services.TransactionBody_FileDelete:
+		return services.HederaFunctionality_FileDelete
+	case *services.TransactionBody_SystemDelete:
+		return services.HederaFunctionality_SystemDelete
+	case *services.TransactionBody_SystemUndelete:
+		return services.HederaFunctionality_SystemUndelete
+	case *services.TransactionBody_Freeze:
+		return services.HederaFunctionality_Freeze
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return services.HederaFunctionality_ConsensusUpdateTopic
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return services.HederaFunctionality_ConsensusDeleteTopic
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return services.HederaFunctionality_ConsensusSubmitMessage
+	case *services.TransactionBody_TokenFreeze:
+		return services.HederaFunctionality_TokenFreezeAccount
+	case *services.TransactionBody_TokenUnfreeze:
+		return services.HederaFunctionality_TokenUnfreezeAccount
+	case *services.TransactionBody_TokenGrantKyc:
+		return services.HederaFunctionality_TokenGrantKycToAccount
+	case *services.TransactionBody_TokenRevokeKyc:
+		return services.HederaFunctionality_TokenRevokeKycFromAccount
+	case *services.TransactionBody_TokenDeletion:
+		return services.HederaFunctionality_TokenDelete
+	case *services.TransactionBody_TokenUpdate:
+		return services.HederaFunctionality_TokenUpdate
+	case *services.TransactionBody_TokenAssociate:
+		return services.HederaFunctionality_TokenAssociateToAccount
+	case *services.TransactionBody_TokenDissociate:
+		return services.HederaFunctionality_TokenDissociateFromAccount
+	case *services.TransactionBody_TokenFeeScheduleUpdate:
+		return services.HederaFunctionality_TokenFeeScheduleUpdate
+	case *services.TransactionBody_TokenPause:
+		return services.HederaFunctionality_TokenPause
+	case *services.TransactionBody_TokenUnpause:
+		return services.HederaFunctionality_TokenUnpause
+	case *services.TransactionBody_TokenUpdateNfts:
+		return services.HederaFunctionality_TokenUpdateNfts
+	case *services.TransactionBody_ScheduleDelete:
+		return services.HederaFunctionality_ScheduleDelete
+	case *services.TransactionBody_ScheduleSign:
+		return services.HederaFunctionality_ScheduleSign
+	case *services.TransactionBody_UtilPrng:
+		return services.HederaFunctionality_UtilPrng
+	}
+
+	return services.HederaFunctionality_NONE
+}
@@ -0,0 +1,123 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func _MockFeeSchedules() FeeSchedules {
+	expiry := time.Now().Add(time.Hour)
+	prices := func(constant int64) *FeeComponents {
+		return &FeeComponents{
+			Min:                        0,
+			Max:                        1_000_000_000_000_000,
+			Constant:                   constant,
+			TransactionBandwidthByte:   1_000,
+			TransactionVerification:    100_000,
+			TransactionRamByteHour:     10,
+			TransactionStorageByteHour: 20,
+		}
+	}
+
+	return FeeSchedules{
+		current: &FeeSchedule{
+			ExpirationTime: &expiry,
+			TransactionFeeSchedules: []TransactionFeeSchedule{
+				{
+					RequestType: RequestTypeCryptoTransfer,
+					Fees: []*FeeData{
+						{NodeData: prices(1_000_000), NetworkData: prices(2_000_000), ServiceData: prices(3_000_000)},
+						{NodeData: prices(5_000_000), NetworkData: prices(6_000_000), ServiceData: prices(7_000_000), SubType: FeeDataTypeTokenFungibleCommon},
+					},
+				},
+				{
+					RequestType: RequestTypeFileCreate,
+					Fees: []*FeeData{
+						{NodeData: prices(1_000_000), NetworkData: prices(2_000_000), ServiceData: prices(3_000_000)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUnitEstimateFeeTransfer(t *testing.T) {
+	t.Parallel()
+
+	transfer := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 5}, HbarFromTinybar(1))
+
+	size, err := transfer.EstimateSize(1)
+	require.NoError(t, err)
+
+	estimate, err := EstimateFee(transfer, _MockFeeSchedules(), ExchangeRate{Hbars: 30000, cents: 100000})
+	require.NoError(t, err)
+
+	require.Equal(t, RequestTypeCryptoTransfer, estimate.RequestType)
+	require.Equal(t, FeeDataTypeDefault, estimate.SubType)
+
+	// constant + bytes + one verification, in thousandths of a tinycent
+	require.Equal(t, (1_000_000+int64(size)*1_000+100_000)/1000, estimate.NodeTinycents)
+	require.Equal(t, int64(3_000_000/1000), estimate.ServiceTinycents)
+	require.Equal(t, HbarFromTinybar(estimate.NodeTinycents*30000/100000), estimate.NodeFee)
+	require.Equal(t, estimate.NodeFee.AsTinybar()+estimate.NetworkFee.AsTinybar()+estimate.ServiceFee.AsTinybar(), estimate.TotalFee.AsTinybar())
+	require.InDelta(t, float64(estimate.NodeTinycents+estimate.NetworkTinycents+estimate.ServiceTinycents)/1e8, estimate.GetTotalUsdCents(), 1e-12)
+}
+
+func TestUnitEstimateFeeSubTypeAndStorage(t *testing.T) {
+	t.Parallel()
+
+	transfer := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		AddTokenTransfer(TokenID{Token: 7}, AccountID{Account: 2}, -1).
+		AddTokenTransfer(TokenID{Token: 7}, AccountID{Account: 5}, 1)
+
+	estimate, err := EstimateFee(transfer, _MockFeeSchedules(), ExchangeRate{Hbars: 1, cents: 12})
+	require.NoError(t, err)
+	require.Equal(t, FeeDataTypeTokenFungibleCommon, estimate.SubType)
+	require.Equal(t, int64(7_000_000/1000), estimate.ServiceTinycents)
+
+	fileCreate := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		SetContents(make([]byte, 1000)).
+		SetExpirationTime(time.Now().Add(2 * time.Hour))
+
+	estimate, err = EstimateFee(fileCreate, _MockFeeSchedules(), ExchangeRate{Hbars: 1, cents: 12})
+	require.NoError(t, err)
+	require.Equal(t, RequestTypeFileCreate, estimate.RequestType)
+	// roughly 2000 byte hours of storage priced at 20 on top of the constant
+	require.Greater(t, estimate.ServiceTinycents, int64(3_000_000/1000))
+
+	_, err = EstimateFee(NewTokenDeleteTransaction().SetTokenID(TokenID{Token: 7}), _MockFeeSchedules(), ExchangeRate{Hbars: 1, cents: 12})
+	require.Error(t, err)
+}
@@ -77,6 +77,16 @@ func (feeSchedules FeeSchedules) _ToProtobuf() *services.CurrentAndNextFeeSchedu
 	}
 }
 
+// GetCurrent returns the fee schedule currently in effect
+func (feeSchedules FeeSchedules) GetCurrent() *FeeSchedule {
+	return feeSchedules.current
+}
+
+// GetNext returns the fee schedule which takes effect once the current one expires
+func (feeSchedules FeeSchedules) GetNext() *FeeSchedule {
+	return feeSchedules.next
+}
+
 // ToBytes returns the byte representation of the FeeSchedules
 func (feeSchedules FeeSchedules) ToBytes() []byte {
 	data, err := protobuf.Marshal(feeSchedules._ToProtobuf())
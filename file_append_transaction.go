@@ -404,6 +404,18 @@ func (tx *FileAppendTransaction) ExecuteAll(
 	return list, nil
 }
 
+// Validate runs local sanity checks against the FileAppendTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *FileAppendTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed FileAppendTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *FileAppendTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *FileAppendTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	chunks := uint64((len(tx.contents) + (tx.chunkSize - 1)) / tx.chunkSize)
 	if chunks > 1 {
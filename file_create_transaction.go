@@ -294,6 +294,18 @@ func (tx *FileCreateTransaction) Execute(client *Client) (TransactionResponse, e
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the FileCreateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *FileCreateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed FileCreateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *FileCreateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *FileCreateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -215,6 +215,18 @@ func (tx *FileDeleteTransaction) Execute(client *Client) (TransactionResponse, e
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the FileDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *FileDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed FileDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *FileDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *FileDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -51,6 +51,27 @@ func FileIDForExchangeRate() FileID {
 	return FileID{File: 112}
 }
 
+// FileIDForNodeAddressBook returns the address book the nodes use amongst themselves, which unlike
+// FileIDForAddressBook includes their RSA public keys and internal endpoints.
+func FileIDForNodeAddressBook() FileID {
+	return FileID{File: 101}
+}
+
+// FileIDForApplicationProperties returns the network's application properties.
+func FileIDForApplicationProperties() FileID {
+	return FileID{File: 121}
+}
+
+// FileIDForApiPermissions returns the accounts permitted to use each network API.
+func FileIDForApiPermissions() FileID {
+	return FileID{File: 122}
+}
+
+// FileIDForThrottleDefinitions returns the network's throttle definitions.
+func FileIDForThrottleDefinitions() FileID {
+	return FileID{File: 123}
+}
+
 // FileIDFromString returns a FileID parsed from the given string.
 // A malformatted string will cause this to return an error instead.
 func FileIDFromString(data string) (FileID, error) {
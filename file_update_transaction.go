@@ -290,6 +290,18 @@ func (tx *FileUpdateTransaction) Execute(client *Client) (TransactionResponse, e
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the FileUpdateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *FileUpdateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed FileUpdateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *FileUpdateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *FileUpdateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
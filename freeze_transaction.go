@@ -252,6 +252,18 @@ func (tx *FreezeTransaction) Execute(client *Client) (TransactionResponse, error
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the FreezeTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *FreezeTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed FreezeTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *FreezeTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *FreezeTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
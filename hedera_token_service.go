@@ -0,0 +1,438 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"github.com/pkg/errors"
+)
+
+// The bits of HTSTokenKey.KeyType selecting the keys of a token the key value is set as
+const (
+	HTSKeyTypeAdmin uint64 = 1 << iota
+	HTSKeyTypeKyc
+	HTSKeyTypeFreeze
+	HTSKeyTypeWipe
+	HTSKeyTypeSupply
+	HTSKeyTypeFeeSchedule
+	HTSKeyTypePause
+)
+
+// HTSKeyValue is the KeyValue struct of IHederaTokenService. Exactly one of its fields is meant to be set.
+type HTSKeyValue struct {
+	InheritAccountKey     bool       `abi:"inheritAccountKey"`
+	ContractID            ContractID `abi:"contractId"`
+	Ed25519               []byte     `abi:"ed25519"`
+	ECDSASecp256k1        []byte     `abi:"ECDSA_secp256k1"`
+	DelegatableContractID ContractID `abi:"delegatableContractId"`
+}
+
+// HTSTokenKey is the TokenKey struct of IHederaTokenService, setting the key value as every key of the token
+// whose HTSKeyType bit is set in KeyType
+type HTSTokenKey struct {
+	KeyType uint64      `abi:"keyType"`
+	Key     HTSKeyValue `abi:"key"`
+}
+
+// HTSExpiry is the Expiry struct of IHederaTokenService
+type HTSExpiry struct {
+	Second           int64     `abi:"second"`
+	AutoRenewAccount AccountID `abi:"autoRenewAccount"`
+	AutoRenewPeriod  int64     `abi:"autoRenewPeriod"`
+}
+
+// HTSToken is the HederaToken struct of IHederaTokenService, the properties of a token to create.
+// TokenSupplyType is true for a finite supply of at most MaxSupply.
+type HTSToken struct {
+	Name            string        `abi:"name"`
+	Symbol          string        `abi:"symbol"`
+	Treasury        AccountID     `abi:"treasury"`
+	Memo            string        `abi:"memo"`
+	TokenSupplyType bool          `abi:"tokenSupplyType"`
+	MaxSupply       int64         `abi:"maxSupply"`
+	FreezeDefault   bool          `abi:"freezeDefault"`
+	TokenKeys       []HTSTokenKey `abi:"tokenKeys"`
+	Expiry          HTSExpiry     `abi:"expiry"`
+}
+
+// HTSFixedFee is the FixedFee struct of IHederaTokenService
+type HTSFixedFee struct {
+	Amount                    int64     `abi:"amount"`
+	TokenID                   TokenID   `abi:"tokenId"`
+	UseHbarsForPayment        bool      `abi:"useHbarsForPayment"`
+	UseCurrentTokenForPayment bool      `abi:"useCurrentTokenForPayment"`
+	FeeCollector              AccountID `abi:"feeCollector"`
+}
+
+// HTSFractionalFee is the FractionalFee struct of IHederaTokenService
+type HTSFractionalFee struct {
+	Numerator      int64     `abi:"numerator"`
+	Denominator    int64     `abi:"denominator"`
+	MinimumAmount  int64     `abi:"minimumAmount"`
+	MaximumAmount  int64     `abi:"maximumAmount"`
+	NetOfTransfers bool      `abi:"netOfTransfers"`
+	FeeCollector   AccountID `abi:"feeCollector"`
+}
+
+// HTSRoyaltyFee is the RoyaltyFee struct of IHederaTokenService
+type HTSRoyaltyFee struct {
+	Numerator          int64     `abi:"numerator"`
+	Denominator        int64     `abi:"denominator"`
+	Amount             int64     `abi:"amount"`
+	TokenID            TokenID   `abi:"tokenId"`
+	UseHbarsForPayment bool      `abi:"useHbarsForPayment"`
+	FeeCollector       AccountID `abi:"feeCollector"`
+}
+
+// HTSTokenInfo is the TokenInfo struct of IHederaTokenService returned by getTokenInfo
+type HTSTokenInfo struct {
+	Token            HTSToken           `abi:"token"`
+	TotalSupply      int64              `abi:"totalSupply"`
+	Deleted          bool               `abi:"deleted"`
+	DefaultKycStatus bool               `abi:"defaultKycStatus"`
+	PauseStatus      bool               `abi:"pauseStatus"`
+	FixedFees        []HTSFixedFee      `abi:"fixedFees"`
+	FractionalFees   []HTSFractionalFee `abi:"fractionalFees"`
+	RoyaltyFees      []HTSRoyaltyFee    `abi:"royaltyFees"`
+	LedgerID         string             `abi:"ledgerId"`
+}
+
+// HTSKeyValueFromKey returns the key value setting the key, which is either a PublicKey, a ContractID or
+// a DelegatableContractID
+func HTSKeyValueFromKey(key Key) (HTSKeyValue, error) {
+	switch k := key.(type) {
+	case PublicKey:
+		if k.ecdsaPublicKey != nil {
+			return HTSKeyValue{ECDSASecp256k1: k.BytesRaw()}, nil
+		}
+		return HTSKeyValue{Ed25519: k.BytesRaw()}, nil
+	case PrivateKey:
+		return HTSKeyValueFromKey(k.PublicKey())
+	case ContractID:
+		return HTSKeyValue{ContractID: k}, nil
+	case DelegatableContractID:
+		return HTSKeyValue{DelegatableContractID: ContractID{Shard: k.Shard, Realm: k.Realm, Contract: k.Contract, EvmAddress: k.EvmAddress}}, nil
+	}
+
+	return HTSKeyValue{}, errors.Errorf("key of type %T can't be set through the token service", key)
+}
+
+const _HTSKeyValueComponents = `[
+	{"name":"inheritAccountKey","type":"bool"},
+	{"name":"contractId","type":"address"},
+	{"name":"ed25519","type":"bytes"},
+	{"name":"ECDSA_secp256k1","type":"bytes"},
+	{"name":"delegatableContractId","type":"address"}]`
+
+const _HTSTokenComponents = `[
+	{"name":"name","type":"string"},
+	{"name":"symbol","type":"string"},
+	{"name":"treasury","type":"address"},
+	{"name":"memo","type":"string"},
+	{"name":"tokenSupplyType","type":"bool"},
+	{"name":"maxSupply","type":"int64"},
+	{"name":"freezeDefault","type":"bool"},
+	{"name":"tokenKeys","type":"tuple[]","components":[
+		{"name":"keyType","type":"uint256"},
+		{"name":"key","type":"tuple","components":` + _HTSKeyValueComponents + `}]},
+	{"name":"expiry","type":"tuple","components":[
+		{"name":"second","type":"int64"},
+		{"name":"autoRenewAccount","type":"address"},
+		{"name":"autoRenewPeriod","type":"int64"}]}]`
+
+const _HTSTokenInfoComponents = `[
+	{"name":"token","type":"tuple","components":` + _HTSTokenComponents + `},
+	{"name":"totalSupply","type":"int64"},
+	{"name":"deleted","type":"bool"},
+	{"name":"defaultKycStatus","type":"bool"},
+	{"name":"pauseStatus","type":"bool"},
+	{"name":"fixedFees","type":"tuple[]","components":[
+		{"name":"amount","type":"int64"},
+		{"name":"tokenId","type":"address"},
+		{"name":"useHbarsForPayment","type":"bool"},
+		{"name":"useCurrentTokenForPayment","type":"bool"},
+		{"name":"feeCollector","type":"address"}]},
+	{"name":"fractionalFees","type":"tuple[]","components":[
+		{"name":"numerator","type":"int64"},
+		{"name":"denominator","type":"int64"},
+		{"name":"minimumAmount","type":"int64"},
+		{"name":"maximumAmount","type":"int64"},
+		{"name":"netOfTransfers","type":"bool"},
+		{"name":"feeCollector","type":"address"}]},
+	{"name":"royaltyFees","type":"tuple[]","components":[
+		{"name":"numerator","type":"int64"},
+		{"name":"denominator","type":"int64"},
+		{"name":"amount","type":"int64"},
+		{"name":"tokenId","type":"address"},
+		{"name":"useHbarsForPayment","type":"bool"},
+		{"name":"feeCollector","type":"address"}]},
+	{"name":"ledgerId","type":"string"}]`
+
+const _HTSResponseCode = `{"name":"responseCode","type":"int64"}`
+
+const _HederaTokenServiceABI = `[
+	{"type":"function","name":"createFungibleToken","stateMutability":"payable",
+		"inputs":[{"name":"token","type":"tuple","components":` + _HTSTokenComponents + `},
+			{"name":"initialTotalSupply","type":"int64"},{"name":"decimals","type":"int32"}],
+		"outputs":[` + _HTSResponseCode + `,{"name":"tokenAddress","type":"address"}]},
+	{"type":"function","name":"createNonFungibleToken","stateMutability":"payable",
+		"inputs":[{"name":"token","type":"tuple","components":` + _HTSTokenComponents + `}],
+		"outputs":[` + _HTSResponseCode + `,{"name":"tokenAddress","type":"address"}]},
+	{"type":"function","name":"associateToken","stateMutability":"nonpayable",
+		"inputs":[{"name":"account","type":"address"},{"name":"token","type":"address"}],
+		"outputs":[` + _HTSResponseCode + `]},
+	{"type":"function","name":"dissociateToken","stateMutability":"nonpayable",
+		"inputs":[{"name":"account","type":"address"},{"name":"token","type":"address"}],
+		"outputs":[` + _HTSResponseCode + `]},
+	{"type":"function","name":"transferToken","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"},{"name":"sender","type":"address"},
+			{"name":"recipient","type":"address"},{"name":"amount","type":"int64"}],
+		"outputs":[` + _HTSResponseCode + `]},
+	{"type":"function","name":"transferNFT","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"},{"name":"sender","type":"address"},
+			{"name":"recipient","type":"address"},{"name":"serialNumber","type":"int64"}],
+		"outputs":[` + _HTSResponseCode + `]},
+	{"type":"function","name":"transferTokens","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"},{"name":"accountId","type":"address[]"},
+			{"name":"amount","type":"int64[]"}],
+		"outputs":[` + _HTSResponseCode + `]},
+	{"type":"function","name":"mintToken","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"},{"name":"amount","type":"int64"},{"name":"metadata","type":"bytes[]"}],
+		"outputs":[` + _HTSResponseCode + `,{"name":"newTotalSupply","type":"int64"},{"name":"serialNumbers","type":"int64[]"}]},
+	{"type":"function","name":"burnToken","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"},{"name":"amount","type":"int64"},{"name":"serialNumbers","type":"int64[]"}],
+		"outputs":[` + _HTSResponseCode + `,{"name":"newTotalSupply","type":"int64"}]},
+	{"type":"function","name":"getTokenInfo","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"}],
+		"outputs":[` + _HTSResponseCode + `,{"name":"tokenInfo","type":"tuple","components":` + _HTSTokenInfoComponents + `}]},
+	{"type":"function","name":"isToken","stateMutability":"nonpayable",
+		"inputs":[{"name":"token","type":"address"}],
+		"outputs":[` + _HTSResponseCode + `,{"name":"isToken","type":"bool"}]}
+]`
+
+// _HRCABI is IHRC719, the interface the address of every token implements on behalf of the calling account
+const _HRCABI = `[
+	{"type":"function","name":"associate","stateMutability":"nonpayable",
+		"inputs":[],"outputs":[{"name":"responseCode","type":"uint256"}]},
+	{"type":"function","name":"dissociate","stateMutability":"nonpayable",
+		"inputs":[],"outputs":[{"name":"responseCode","type":"uint256"}]},
+	{"type":"function","name":"isAssociated","stateMutability":"view",
+		"inputs":[],"outputs":[{"name":"associated","type":"bool"}]}
+]`
+
+var (
+	_HederaTokenServiceContractABI = _MustContractABI(_HederaTokenServiceABI)
+	_HRCContractABI                = _MustContractABI(_HRCABI)
+)
+
+// HTSCreateFungibleToken encodes the call of the token service creating a fungible token. The call must pay
+// the token creation fee, see ContractExecuteTransaction.SetPayableAmount.
+func HTSCreateFungibleToken(token HTSToken, initialTotalSupply int64, decimals int32) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("createFungibleToken", token, initialTotalSupply, decimals)
+}
+
+// HTSCreateNonFungibleToken encodes the call of the token service creating a non fungible token. The call must
+// pay the token creation fee, see ContractExecuteTransaction.SetPayableAmount.
+func HTSCreateNonFungibleToken(token HTSToken) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("createNonFungibleToken", token)
+}
+
+// HTSAssociateToken encodes the call of the token service associating the account with the token
+func HTSAssociateToken(accountID AccountID, tokenID TokenID) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("associateToken", accountID, tokenID)
+}
+
+// HTSDissociateToken encodes the call of the token service dissociating the account from the token
+func HTSDissociateToken(accountID AccountID, tokenID TokenID) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("dissociateToken", accountID, tokenID)
+}
+
+// HTSTransferToken encodes the call of the token service transferring an amount of the fungible token
+func HTSTransferToken(tokenID TokenID, sender AccountID, recipient AccountID, amount int64) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("transferToken", tokenID, sender, recipient, amount)
+}
+
+// HTSTransferNFT encodes the call of the token service transferring the NFT with the serial number
+func HTSTransferNFT(tokenID TokenID, sender AccountID, recipient AccountID, serialNumber int64) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("transferNFT", tokenID, sender, recipient, serialNumber)
+}
+
+// HTSTransferTokens encodes the call of the token service transferring the fungible token between the accounts,
+// each sending a negative or receiving a positive amount. The amounts must add up to zero.
+func HTSTransferTokens(tokenID TokenID, accountIDs []AccountID, amounts []int64) ([]byte, error) {
+	if len(accountIDs) != len(amounts) {
+		return nil, errors.Errorf("%d accounts but %d amounts", len(accountIDs), len(amounts))
+	}
+
+	return _HederaTokenServiceContractABI.Pack("transferTokens", tokenID, accountIDs, amounts)
+}
+
+// HTSMintToken encodes the call of the token service minting an amount of the fungible token, or an NFT for
+// each of the metadata of the non fungible token
+func HTSMintToken(tokenID TokenID, amount int64, metadata [][]byte) ([]byte, error) {
+	if metadata == nil {
+		metadata = [][]byte{}
+	}
+
+	return _HederaTokenServiceContractABI.Pack("mintToken", tokenID, amount, metadata)
+}
+
+// HTSBurnToken encodes the call of the token service burning an amount of the fungible token, or the NFTs with
+// the serial numbers of the non fungible token
+func HTSBurnToken(tokenID TokenID, amount int64, serialNumbers []int64) ([]byte, error) {
+	if serialNumbers == nil {
+		serialNumbers = []int64{}
+	}
+
+	return _HederaTokenServiceContractABI.Pack("burnToken", tokenID, amount, serialNumbers)
+}
+
+// HTSGetTokenInfo encodes the call of the token service returning the information of the token
+func HTSGetTokenInfo(tokenID TokenID) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("getTokenInfo", tokenID)
+}
+
+// HTSIsToken encodes the call of the token service returning whether the address is a token
+func HTSIsToken(tokenID TokenID) ([]byte, error) {
+	return _HederaTokenServiceContractABI.Pack("isToken", tokenID)
+}
+
+// HTSDecodeResponseCode decodes the response code of the token service returned by the functions which return
+// nothing else, such as associateToken and transferToken
+func HTSDecodeResponseCode(output []byte) (Status, error) {
+	var result struct {
+		ResponseCode int64
+	}
+	if err := _HederaTokenServiceContractABI.UnpackInto(&result, "associateToken", output); err != nil {
+		return 0, err
+	}
+
+	return Status(result.ResponseCode), nil
+}
+
+// HTSDecodeCreateToken decodes the response code and the ID of the token created by createFungibleToken or
+// createNonFungibleToken
+func HTSDecodeCreateToken(output []byte) (Status, TokenID, error) {
+	var result struct {
+		ResponseCode int64
+		TokenAddress TokenID
+	}
+	if err := _HederaTokenServiceContractABI.UnpackInto(&result, "createFungibleToken", output); err != nil {
+		return 0, TokenID{}, err
+	}
+
+	return Status(result.ResponseCode), result.TokenAddress, nil
+}
+
+// HTSDecodeMintToken decodes the response code, the new total supply and the serial numbers of the minted NFTs
+// returned by mintToken
+func HTSDecodeMintToken(output []byte) (Status, int64, []int64, error) {
+	var result struct {
+		ResponseCode   int64
+		NewTotalSupply int64
+		SerialNumbers  []int64
+	}
+	if err := _HederaTokenServiceContractABI.UnpackInto(&result, "mintToken", output); err != nil {
+		return 0, 0, nil, err
+	}
+
+	return Status(result.ResponseCode), result.NewTotalSupply, result.SerialNumbers, nil
+}
+
+// HTSDecodeBurnToken decodes the response code and the new total supply returned by burnToken
+func HTSDecodeBurnToken(output []byte) (Status, int64, error) {
+	var result struct {
+		ResponseCode   int64
+		NewTotalSupply int64
+	}
+	if err := _HederaTokenServiceContractABI.UnpackInto(&result, "burnToken", output); err != nil {
+		return 0, 0, err
+	}
+
+	return Status(result.ResponseCode), result.NewTotalSupply, nil
+}
+
+// HTSDecodeTokenInfo decodes the response code and the token information returned by getTokenInfo
+func HTSDecodeTokenInfo(output []byte) (Status, HTSTokenInfo, error) {
+	var result struct {
+		ResponseCode int64
+		TokenInfo    HTSTokenInfo
+	}
+	if err := _HederaTokenServiceContractABI.UnpackInto(&result, "getTokenInfo", output); err != nil {
+		return 0, HTSTokenInfo{}, err
+	}
+
+	return Status(result.ResponseCode), result.TokenInfo, nil
+}
+
+// HTSDecodeIsToken decodes the response code and whether the address is a token returned by isToken
+func HTSDecodeIsToken(output []byte) (Status, bool, error) {
+	var result struct {
+		ResponseCode int64
+		IsToken      bool
+	}
+	if err := _HederaTokenServiceContractABI.UnpackInto(&result, "isToken", output); err != nil {
+		return 0, false, err
+	}
+
+	return Status(result.ResponseCode), result.IsToken, nil
+}
+
+// HRCContractID returns the ContractID to call the IHRC functions of the token at, the address of the token
+func HRCContractID(tokenID TokenID) ContractID {
+	return ContractID{Shard: tokenID.Shard, Realm: tokenID.Realm, Contract: tokenID.Token}
+}
+
+// HRCAssociate encodes the call of IHRC associating the calling account with the token called
+func HRCAssociate() ([]byte, error) {
+	return _HRCContractABI.Pack("associate")
+}
+
+// HRCDissociate encodes the call of IHRC dissociating the calling account from the token called
+func HRCDissociate() ([]byte, error) {
+	return _HRCContractABI.Pack("dissociate")
+}
+
+// HRCIsAssociated encodes the call of IHRC returning whether the calling account is associated with the token called
+func HRCIsAssociated() ([]byte, error) {
+	return _HRCContractABI.Pack("isAssociated")
+}
+
+// HRCDecodeResponseCode decodes the response code returned by associate and dissociate
+func HRCDecodeResponseCode(output []byte) (Status, error) {
+	var result struct {
+		ResponseCode uint32
+	}
+	if err := _HRCContractABI.UnpackInto(&result, "associate", output); err != nil {
+		return 0, err
+	}
+
+	return Status(result.ResponseCode), nil
+}
+
+// HRCDecodeIsAssociated decodes whether the calling account is associated with the token, returned by isAssociated
+func HRCDecodeIsAssociated(output []byte) (bool, error) {
+	var associated bool
+	if err := _HRCContractABI.UnpackInto(&associated, "isAssociated", output); err != nil {
+		return false, err
+	}
+
+	return associated, nil
+}
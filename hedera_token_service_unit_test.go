@@ -0,0 +1,215 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestPackOutputs(t *testing.T, contractABI *ContractABI, name string, values ...interface{}) []byte {
+	method, err := contractABI._Method(name)
+	require.NoError(t, err)
+
+	converted, err := _ABIConvertArguments(method.Outputs, values)
+	require.NoError(t, err)
+
+	data, err := method.Outputs.Pack(converted...)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestUnitHederaTokenServiceSelectors(t *testing.T) {
+	t.Parallel()
+
+	selector := func(signature string) string {
+		return hex.EncodeToString(crypto.Keccak256([]byte(signature))[:4])
+	}
+	token := "(string,string,address,string,bool,int64,bool,(uint256,(bool,address,bytes,bytes,address))[],(int64,address,int64))"
+
+	data, err := HTSCreateFungibleToken(HTSToken{Name: "Token", Symbol: "T"}, 1000, 2)
+	require.NoError(t, err)
+	require.Equal(t, selector("createFungibleToken("+token+",int64,int32)"), hex.EncodeToString(data[:4]))
+
+	data, err = HTSCreateNonFungibleToken(HTSToken{Name: "NFT", Symbol: "N"})
+	require.NoError(t, err)
+	require.Equal(t, selector("createNonFungibleToken("+token+")"), hex.EncodeToString(data[:4]))
+
+	tokenID := TokenID{Token: 1234}
+	accountID := AccountID{Account: 5678}
+
+	selectors := []struct {
+		selector string
+		encode   func() ([]byte, error)
+	}{
+		{"49146bde", func() ([]byte, error) { return HTSAssociateToken(accountID, tokenID) }},
+		{"099794e8", func() ([]byte, error) { return HTSDissociateToken(accountID, tokenID) }},
+		{"eca36917", func() ([]byte, error) { return HTSTransferToken(tokenID, accountID, accountID, 1) }},
+		{"5cfc9011", func() ([]byte, error) { return HTSTransferNFT(tokenID, accountID, accountID, 1) }},
+		{"e0f4059a", func() ([]byte, error) { return HTSMintToken(tokenID, 1, nil) }},
+		{"d6910d06", func() ([]byte, error) { return HTSBurnToken(tokenID, 1, nil) }},
+		{"1f69565f", func() ([]byte, error) { return HTSGetTokenInfo(tokenID) }},
+		{"19f37361", func() ([]byte, error) { return HTSIsToken(tokenID) }},
+		{"0a754de6", HRCAssociate},
+		{"5c9217e0", HRCDissociate},
+		{"4d8fdd6d", HRCIsAssociated},
+	}
+	for _, test := range selectors {
+		data, err := test.encode()
+		require.NoError(t, err)
+		require.Equal(t, test.selector, hex.EncodeToString(data[:4]))
+	}
+
+	data, err = HTSTransferTokens(tokenID, []AccountID{accountID, {Account: 9}}, []int64{-5, 5})
+	require.NoError(t, err)
+	require.Equal(t, "82bba493", hex.EncodeToString(data[:4]))
+
+	_, err = HTSTransferTokens(tokenID, []AccountID{accountID}, []int64{-5, 5})
+	require.Error(t, err)
+
+	data, err = HRCAssociate()
+	require.NoError(t, err)
+	require.Len(t, data, 4)
+}
+
+func TestUnitHederaTokenServiceAssociateToken(t *testing.T) {
+	t.Parallel()
+
+	data, err := HTSAssociateToken(AccountID{Account: 5678}, TokenID{Token: 1234})
+	require.NoError(t, err)
+	require.Equal(t, "49146bde"+
+		"000000000000000000000000000000000000000000000000000000000000162e"+
+		"00000000000000000000000000000000000000000000000000000000000004d2", hex.EncodeToString(data))
+}
+
+func TestUnitHederaTokenServiceDecode(t *testing.T) {
+	t.Parallel()
+
+	status, err := HTSDecodeResponseCode(_TestPackOutputs(t, _HederaTokenServiceContractABI, "associateToken", int64(22)))
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, status)
+
+	status, err = HTSDecodeResponseCode(_TestPackOutputs(t, _HederaTokenServiceContractABI, "associateToken", int64(StatusTokenAlreadyAssociatedToAccount)))
+	require.NoError(t, err)
+	require.Equal(t, StatusTokenAlreadyAssociatedToAccount, status)
+
+	status, tokenID, err := HTSDecodeCreateToken(_TestPackOutputs(t, _HederaTokenServiceContractABI, "createFungibleToken", int64(22), TokenID{Token: 1234}))
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, status)
+	require.Equal(t, TokenID{Token: 1234}, tokenID)
+
+	status, totalSupply, serialNumbers, err := HTSDecodeMintToken(_TestPackOutputs(t, _HederaTokenServiceContractABI, "mintToken", int64(22), int64(3), []int64{1, 2, 3}))
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, status)
+	require.Equal(t, int64(3), totalSupply)
+	require.Equal(t, []int64{1, 2, 3}, serialNumbers)
+
+	status, totalSupply, err = HTSDecodeBurnToken(_TestPackOutputs(t, _HederaTokenServiceContractABI, "burnToken", int64(StatusInvalidTokenBurnAmount), int64(3)))
+	require.NoError(t, err)
+	require.Equal(t, StatusInvalidTokenBurnAmount, status)
+	require.Equal(t, int64(3), totalSupply)
+
+	status, isToken, err := HTSDecodeIsToken(_TestPackOutputs(t, _HederaTokenServiceContractABI, "isToken", int64(22), true))
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, status)
+	require.True(t, isToken)
+
+	status, err = HRCDecodeResponseCode(_TestPackOutputs(t, _HRCContractABI, "associate", uint64(22)))
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, status)
+
+	associated, err := HRCDecodeIsAssociated(_TestPackOutputs(t, _HRCContractABI, "isAssociated", true))
+	require.NoError(t, err)
+	require.True(t, associated)
+
+	_, err = HTSDecodeResponseCode([]byte{1, 2})
+	require.Error(t, err)
+}
+
+func TestUnitHederaTokenServiceTokenInfo(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	keyValue, err := HTSKeyValueFromKey(key.PublicKey())
+	require.NoError(t, err)
+	require.Len(t, keyValue.ECDSASecp256k1, 33)
+	keyValue.Ed25519 = []byte{}
+
+	evmAddress := make([]byte, 20)
+	evmAddress[0] = 0xab
+	info := HTSTokenInfo{
+		Token: HTSToken{
+			Name:     "Token",
+			Symbol:   "T",
+			Treasury: AccountID{Account: 5678},
+			Memo:     "memo",
+			TokenKeys: []HTSTokenKey{
+				{KeyType: HTSKeyTypeAdmin | HTSKeyTypeSupply, Key: keyValue},
+				{KeyType: HTSKeyTypePause, Key: HTSKeyValue{ContractID: ContractID{Contract: 99}, Ed25519: []byte{}, ECDSASecp256k1: []byte{}}},
+			},
+			Expiry: HTSExpiry{Second: 1700000000, AutoRenewAccount: AccountID{AliasEvmAddress: &evmAddress}, AutoRenewPeriod: 7776000},
+		},
+		TotalSupply:    1000,
+		FixedFees:      []HTSFixedFee{{Amount: 1, UseHbarsForPayment: true, FeeCollector: AccountID{Account: 7}}},
+		FractionalFees: []HTSFractionalFee{{Numerator: 1, Denominator: 10, FeeCollector: AccountID{Account: 7}}},
+		RoyaltyFees:    []HTSRoyaltyFee{},
+		LedgerID:       "0x01",
+	}
+
+	status, decoded, err := HTSDecodeTokenInfo(_TestPackOutputs(t, _HederaTokenServiceContractABI, "getTokenInfo", int64(22), info))
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, status)
+	require.Equal(t, info, decoded)
+
+	data, err := HTSCreateFungibleToken(info.Token, 1000, 2)
+	require.NoError(t, err)
+	method, err := _HederaTokenServiceContractABI._Method("createFungibleToken")
+	require.NoError(t, err)
+	values, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1000), int32(2)}, values[1:])
+}
+
+func TestUnitHTSKeyValueFromKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	keyValue, err := HTSKeyValueFromKey(key)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey().BytesRaw(), keyValue.Ed25519)
+
+	keyValue, err = HTSKeyValueFromKey(DelegatableContractID{Contract: 3})
+	require.NoError(t, err)
+	require.Equal(t, ContractID{Contract: 3}, keyValue.DelegatableContractID)
+
+	_, err = HTSKeyValueFromKey(KeyListWithThreshold(1))
+	require.Error(t, err)
+}
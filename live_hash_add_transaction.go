@@ -283,6 +283,18 @@ func (tx *LiveHashAddTransaction) Execute(client *Client) (TransactionResponse,
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the LiveHashAddTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *LiveHashAddTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed LiveHashAddTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *LiveHashAddTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *LiveHashAddTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
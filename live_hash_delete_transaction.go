@@ -204,6 +204,18 @@ func (tx *LiveHashDeleteTransaction) Execute(client *Client) (TransactionRespons
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the LiveHashDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *LiveHashDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed LiveHashDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *LiveHashDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *LiveHashDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -0,0 +1,595 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const mirrorClientDefaultTimeout = 30 * time.Second
+
+// Polling used against a local mirror node when the client doesn't configure any, as it usually
+// lags behind the consensus node it follows
+const localMirrorPollTimeout = 10 * time.Second
+const localMirrorPollInterval = 250 * time.Millisecond
+
+// MirrorClient is a typed client for the mirror node REST API.
+type MirrorClient struct {
+	baseURL      string
+	httpClient   *http.Client
+	pollTimeout  time.Duration
+	pollInterval time.Duration
+}
+
+// NewMirrorClient creates a MirrorClient for the mirror node REST API at baseURL,
+// e.g. "https://testnet.mirrornode.hedera.com". The "/api/v1" suffix is optional.
+func NewMirrorClient(baseURL string) *MirrorClient {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if !strings.HasSuffix(baseURL, API_VERSION) {
+		baseURL += API_VERSION
+	}
+
+	return &MirrorClient{
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: mirrorClientDefaultTimeout},
+	}
+}
+
+// MirrorClientFromClient creates a MirrorClient for the mirror node REST API of the client's network,
+// using the client's mirror REST URL and polling configuration
+func MirrorClientFromClient(client *Client) *MirrorClient {
+	mirror := NewMirrorClient(fetchMirrorNodeUrlFromClient(client))
+	if client.mirrorPollSet {
+		return mirror.SetPolling(client.mirrorPollTimeout, client.mirrorPollInterval)
+	}
+	if client.mirrorRestURL == "" && strings.Contains(mirror.baseURL, LOCAL_NETWORK) {
+		return mirror.SetPolling(localMirrorPollTimeout, localMirrorPollInterval)
+	}
+
+	return mirror
+}
+
+// SetHTTPClient sets the HTTP client used for requests
+func (mirror *MirrorClient) SetHTTPClient(httpClient *http.Client) *MirrorClient {
+	mirror.httpClient = httpClient
+	return mirror
+}
+
+// SetPolling enables waiting for the mirror node to catch up with the network. Requests for entities
+// the mirror node hasn't ingested yet are retried every interval until timeout, and
+// WaitForConsensusTimestamp gives up after timeout. A zero timeout disables polling.
+func (mirror *MirrorClient) SetPolling(timeout time.Duration, interval time.Duration) *MirrorClient {
+	mirror.pollTimeout = timeout
+	mirror.pollInterval = interval
+	return mirror
+}
+
+// GetPollingTimeout returns how long requests wait for the mirror node to catch up
+func (mirror *MirrorClient) GetPollingTimeout() time.Duration {
+	return mirror.pollTimeout
+}
+
+// GetPollingInterval returns the interval between polls of the mirror node
+func (mirror *MirrorClient) GetPollingInterval() time.Duration {
+	return mirror.pollInterval
+}
+
+// WaitForConsensusTimestamp blocks until the mirror node has ingested a block ending at or after
+// timestamp. Without polling enabled it checks once.
+func (mirror *MirrorClient) WaitForConsensusTimestamp(ctx context.Context, timestamp time.Time) error {
+	deadline := time.Now().Add(mirror.pollTimeout)
+	requestURL := mirror._URL(url.Values{"limit": {"1"}, "order": {"desc"}}, "blocks")
+
+	for {
+		var page struct {
+			Blocks []struct {
+				Timestamp MirrorTimestampRange `json:"timestamp"`
+			} `json:"blocks"`
+		}
+		if err := mirror._Get(ctx, requestURL, &page); err != nil {
+			return err
+		}
+		if len(page.Blocks) > 0 {
+			latest, err := page.Blocks[0].Timestamp.To.Time()
+			if err != nil {
+				return err
+			}
+			if !latest.Before(timestamp) {
+				return nil
+			}
+		}
+
+		if !time.Now().Add(mirror.pollInterval).Before(deadline) {
+			return ErrMirrorNodeIngestionTimeout{Timestamp: timestamp}
+		}
+		if err := _MirrorPollSleep(ctx, mirror.pollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// GetBaseURL returns the base URL of the REST API, including the API version
+func (mirror *MirrorClient) GetBaseURL() string {
+	return mirror.baseURL
+}
+
+// ListAccounts returns an iterator over the accounts matching params
+func (mirror *MirrorClient) ListAccounts(ctx context.Context, params url.Values) *MirrorIterator[MirrorAccount] {
+	return _NewMirrorIterator[MirrorAccount](ctx, mirror, mirror._URL(params, "accounts"), "accounts")
+}
+
+// GetAccount returns the account with the given ID, alias or EVM address
+func (mirror *MirrorClient) GetAccount(ctx context.Context, idOrAlias string) (MirrorAccount, error) {
+	var account MirrorAccount
+	err := mirror._Get(ctx, mirror._URL(nil, "accounts", idOrAlias), &account)
+	return account, err
+}
+
+// ListAccountNfts returns an iterator over the NFTs owned by the account
+func (mirror *MirrorClient) ListAccountNfts(ctx context.Context, idOrAlias string, params url.Values) *MirrorIterator[MirrorNft] {
+	return _NewMirrorIterator[MirrorNft](ctx, mirror, mirror._URL(params, "accounts", idOrAlias, "nfts"), "nfts")
+}
+
+// ListCryptoAllowances returns an iterator over the hbar allowances granted by the account
+func (mirror *MirrorClient) ListCryptoAllowances(ctx context.Context, idOrAlias string, params url.Values) *MirrorIterator[MirrorCryptoAllowance] {
+	return _NewMirrorIterator[MirrorCryptoAllowance](ctx, mirror, mirror._URL(params, "accounts", idOrAlias, "allowances", "crypto"), "allowances")
+}
+
+// ListTokenAllowances returns an iterator over the fungible token allowances granted by the account
+func (mirror *MirrorClient) ListTokenAllowances(ctx context.Context, idOrAlias string, params url.Values) *MirrorIterator[MirrorTokenAllowance] {
+	return _NewMirrorIterator[MirrorTokenAllowance](ctx, mirror, mirror._URL(params, "accounts", idOrAlias, "allowances", "tokens"), "allowances")
+}
+
+// ListNftAllowances returns an iterator over the NFT allowances granted by or to the account
+func (mirror *MirrorClient) ListNftAllowances(ctx context.Context, idOrAlias string, params url.Values) *MirrorIterator[MirrorNftAllowance] {
+	return _NewMirrorIterator[MirrorNftAllowance](ctx, mirror, mirror._URL(params, "accounts", idOrAlias, "allowances", "nfts"), "allowances")
+}
+
+// ListStakingRewards returns an iterator over the staking rewards paid to the account
+func (mirror *MirrorClient) ListStakingRewards(ctx context.Context, idOrAlias string, params url.Values) *MirrorIterator[MirrorStakingReward] {
+	return _NewMirrorIterator[MirrorStakingReward](ctx, mirror, mirror._URL(params, "accounts", idOrAlias, "rewards"), "rewards")
+}
+
+// ListTransactions returns an iterator over the transactions matching params
+func (mirror *MirrorClient) ListTransactions(ctx context.Context, params url.Values) *MirrorIterator[MirrorTransaction] {
+	return _NewMirrorIterator[MirrorTransaction](ctx, mirror, mirror._URL(params, "transactions"), "transactions")
+}
+
+// GetTransactions returns the transaction with the given ID along with its child and scheduled
+// transactions. transactionID may be given in either the SDK or the mirror node format. While
+// polling is enabled, it waits for the mirror node to ingest the transaction.
+func (mirror *MirrorClient) GetTransactions(ctx context.Context, transactionID string) ([]MirrorTransaction, error) {
+	var page struct {
+		Transactions []MirrorTransaction `json:"transactions"`
+	}
+	err := mirror._GetWithPolling(ctx, mirror._URL(nil, "transactions", _MirrorTransactionID(transactionID)), &page)
+	return page.Transactions, err
+}
+
+// ListTokens returns an iterator over the tokens matching params
+func (mirror *MirrorClient) ListTokens(ctx context.Context, params url.Values) *MirrorIterator[MirrorToken] {
+	return _NewMirrorIterator[MirrorToken](ctx, mirror, mirror._URL(params, "tokens"), "tokens")
+}
+
+// ListTokenBalances returns an iterator over the balances held of the token
+func (mirror *MirrorClient) ListTokenBalances(ctx context.Context, tokenID string, params url.Values) *MirrorIterator[MirrorTokenBalance] {
+	return _NewMirrorIterator[MirrorTokenBalance](ctx, mirror, mirror._URL(params, "tokens", tokenID, "balances"), "balances")
+}
+
+// ListTokenNfts returns an iterator over the NFTs of the token
+func (mirror *MirrorClient) ListTokenNfts(ctx context.Context, tokenID string, params url.Values) *MirrorIterator[MirrorNft] {
+	return _NewMirrorIterator[MirrorNft](ctx, mirror, mirror._URL(params, "tokens", tokenID, "nfts"), "nfts")
+}
+
+// ListTopicMessages returns an iterator over the messages submitted to the topic
+func (mirror *MirrorClient) ListTopicMessages(ctx context.Context, topicID string, params url.Values) *MirrorIterator[MirrorTopicMessage] {
+	return _NewMirrorIterator[MirrorTopicMessage](ctx, mirror, mirror._URL(params, "topics", topicID, "messages"), "messages")
+}
+
+// GetContract returns the contract with the ID or EVM address
+func (mirror *MirrorClient) GetContract(ctx context.Context, idOrAddress string) (MirrorContract, error) {
+	var contract MirrorContract
+	err := mirror._Get(ctx, mirror._URL(nil, "contracts", idOrAddress), &contract)
+	return contract, err
+}
+
+// ListContractResults returns an iterator over the results of contract calls matching params
+func (mirror *MirrorClient) ListContractResults(ctx context.Context, params url.Values) *MirrorIterator[MirrorContractResult] {
+	return _NewMirrorIterator[MirrorContractResult](ctx, mirror, mirror._URL(params, "contracts", "results"), "results")
+}
+
+// GetContractResult returns the result of the contract call of the transaction with the ID or Ethereum hash
+func (mirror *MirrorClient) GetContractResult(ctx context.Context, transactionIDOrHash string) (MirrorContractResult, error) {
+	var result MirrorContractResult
+	err := mirror._GetWithPolling(ctx, mirror._URL(nil, "contracts", "results", _MirrorTransactionID(transactionIDOrHash)), &result)
+	return result, err
+}
+
+// ListContractLogs returns an iterator over the logs emitted by the contract
+func (mirror *MirrorClient) ListContractLogs(ctx context.Context, contractIDOrAddress string, params url.Values) *MirrorIterator[MirrorContractLog] {
+	return _NewMirrorIterator[MirrorContractLog](ctx, mirror, mirror._URL(params, "contracts", contractIDOrAddress, "results", "logs"), "logs")
+}
+
+// ListAllContractLogs returns an iterator over the logs emitted by all contracts matching params
+func (mirror *MirrorClient) ListAllContractLogs(ctx context.Context, params url.Values) *MirrorIterator[MirrorContractLog] {
+	return _NewMirrorIterator[MirrorContractLog](ctx, mirror, mirror._URL(params, "contracts", "results", "logs"), "logs")
+}
+
+// ListSchedules returns an iterator over the schedules matching params
+func (mirror *MirrorClient) ListSchedules(ctx context.Context, params url.Values) *MirrorIterator[MirrorSchedule] {
+	return _NewMirrorIterator[MirrorSchedule](ctx, mirror, mirror._URL(params, "schedules"), "schedules")
+}
+
+// ListNetworkNodes returns an iterator over the nodes of the network
+func (mirror *MirrorClient) ListNetworkNodes(ctx context.Context, params url.Values) *MirrorIterator[MirrorNetworkNode] {
+	return _NewMirrorIterator[MirrorNetworkNode](ctx, mirror, mirror._URL(params, "network", "nodes"), "nodes")
+}
+
+// GetNetworkSupply returns the total and released supply of hbar
+func (mirror *MirrorClient) GetNetworkSupply(ctx context.Context, params url.Values) (MirrorNetworkSupply, error) {
+	var supply MirrorNetworkSupply
+	err := mirror._Get(ctx, mirror._URL(params, "network", "supply"), &supply)
+	return supply, err
+}
+
+// GetNetworkFees returns the gas price of the transactions which may consume gas
+func (mirror *MirrorClient) GetNetworkFees(ctx context.Context, params url.Values) (MirrorNetworkFees, error) {
+	var fees MirrorNetworkFees
+	err := mirror._Get(ctx, mirror._URL(params, "network", "fees"), &fees)
+	return fees, err
+}
+
+// GetExchangeRate returns the current and next exchange rate between hbar and USD
+func (mirror *MirrorClient) GetExchangeRate(ctx context.Context, params url.Values) (MirrorExchangeRateSet, error) {
+	var rates MirrorExchangeRateSet
+	err := mirror._Get(ctx, mirror._URL(params, "network", "exchangerate"), &rates)
+	return rates, err
+}
+
+// CallContract simulates a contract call, or with Estimate set estimates its gas, using the
+// mirror node's copy of the state. Nothing is submitted to the network and no hbar is spent.
+func (mirror *MirrorClient) CallContract(ctx context.Context, call MirrorContractCall) (MirrorContractCallResult, error) {
+	var result MirrorContractCallResult
+	err := mirror._Post(ctx, mirror._URL(nil, "contracts", "call"), call, &result)
+	return result, err
+}
+
+func (mirror *MirrorClient) _URL(params url.Values, path ...string) string {
+	requestURL := mirror.baseURL
+	for _, segment := range path {
+		requestURL += "/" + url.PathEscape(segment)
+	}
+	if len(params) > 0 {
+		requestURL += "?" + params.Encode()
+	}
+
+	return requestURL
+}
+
+// _Resolve turns the path of a `links.next` into an absolute URL on the same host
+func (mirror *MirrorClient) _Resolve(next string) (string, error) {
+	base, err := url.Parse(mirror.baseURL)
+	if err != nil {
+		return "", err
+	}
+	reference, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+
+	return base.ResolveReference(reference).String(), nil
+}
+
+func (mirror *MirrorClient) _Get(ctx context.Context, requestURL string, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	return mirror._Do(request, result)
+}
+
+// _GetWithPolling is _Get which, while polling is enabled, retries requests answered with
+// 404 Not Found as the entity may not have been ingested yet
+func (mirror *MirrorClient) _GetWithPolling(ctx context.Context, requestURL string, result interface{}) error {
+	deadline := time.Now().Add(mirror.pollTimeout)
+
+	for {
+		err := mirror._Get(ctx, requestURL, result)
+
+		var responseErr ErrMirrorNodeResponse
+		if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusNotFound {
+			return err
+		}
+		if !time.Now().Add(mirror.pollInterval).Before(deadline) {
+			return err
+		}
+		if err := _MirrorPollSleep(ctx, mirror.pollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+func _MirrorPollSleep(ctx context.Context, interval time.Duration) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (mirror *MirrorClient) _Post(ctx context.Context, requestURL string, body interface{}, result interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	return mirror._Do(request, result)
+}
+
+func (mirror *MirrorClient) _Do(request *http.Request, result interface{}) error {
+	response, err := mirror.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return _MirrorResponseError(request.URL.String(), response.StatusCode, body)
+	}
+
+	return json.Unmarshal(body, result)
+}
+
+func _MirrorResponseError(requestURL string, statusCode int, body []byte) ErrMirrorNodeResponse {
+	var status struct {
+		Status struct {
+			Messages []struct {
+				Message string `json:"message"`
+				Detail  string `json:"detail"`
+				Data    string `json:"data"`
+			} `json:"messages"`
+		} `json:"_status"`
+	}
+	messages := make([]string, 0)
+	data := ""
+	if json.Unmarshal(body, &status) == nil {
+		for _, message := range status.Status.Messages {
+			if data == "" {
+				data = message.Data
+			}
+			if message.Detail != "" {
+				messages = append(messages, message.Message+": "+message.Detail)
+			} else {
+				messages = append(messages, message.Message)
+			}
+		}
+	}
+
+	return ErrMirrorNodeResponse{
+		URL:        requestURL,
+		StatusCode: statusCode,
+		Messages:   messages,
+		Data:       data,
+	}
+}
+
+// _MirrorTransactionID converts a transaction ID such as "0.0.2@1700000000.123" into the
+// "0.0.2-1700000000-000000123" form the mirror node expects in paths
+func _MirrorTransactionID(transactionID string) string {
+	at := strings.Index(transactionID, "@")
+	if at < 0 {
+		return transactionID
+	}
+
+	account := transactionID[:at]
+	validStart := transactionID[at+1:]
+	if question := strings.Index(validStart, "?"); question >= 0 {
+		validStart = validStart[:question]
+	}
+	if slash := strings.Index(validStart, "/"); slash >= 0 {
+		validStart = validStart[:slash]
+	}
+
+	seconds, nanos := validStart, "0"
+	if dot := strings.Index(validStart, "."); dot >= 0 {
+		seconds, nanos = validStart[:dot], validStart[dot+1:]
+	}
+	for len(nanos) < 9 {
+		nanos = "0" + nanos
+	}
+
+	return account + "-" + seconds + "-" + nanos
+}
+
+// MirrorIterator walks every item of a paginated mirror node REST endpoint, following `links.next`
+// until the last page.
+//
+//	iterator := mirror.ListAccounts(ctx, nil)
+//	for iterator.Next() {
+//		account := iterator.Item()
+//	}
+//	if err := iterator.Err(); err != nil {
+//		...
+//	}
+type MirrorIterator[T any] struct {
+	ctx     context.Context
+	mirror  *MirrorClient
+	next    string
+	key     string
+	items   []T
+	current T
+	err     error
+}
+
+func _NewMirrorIterator[T any](ctx context.Context, mirror *MirrorClient, requestURL string, key string) *MirrorIterator[T] {
+	return &MirrorIterator[T]{
+		ctx:    ctx,
+		mirror: mirror,
+		next:   requestURL,
+		key:    key,
+	}
+}
+
+// Next advances to the next item, fetching the next page when required. It returns false when
+// there are no more items or a request failed, which Err reports.
+func (iterator *MirrorIterator[T]) Next() bool {
+	for len(iterator.items) == 0 {
+		if iterator.err != nil || iterator.next == "" {
+			return false
+		}
+		if err := iterator._FetchPage(); err != nil {
+			iterator.err = err
+			return false
+		}
+	}
+
+	iterator.current = iterator.items[0]
+	iterator.items = iterator.items[1:]
+
+	return true
+}
+
+// Item returns the item Next advanced to
+func (iterator *MirrorIterator[T]) Item() T {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *MirrorIterator[T]) Err() error {
+	return iterator.err
+}
+
+// GetNextURL returns the URL of the page which will be fetched once the current page is consumed,
+// or "" on the last page. It can be stored to resume the iteration later.
+func (iterator *MirrorIterator[T]) GetNextURL() string {
+	return iterator.next
+}
+
+// All consumes the rest of the iterator and returns every item
+func (iterator *MirrorIterator[T]) All() ([]T, error) {
+	items := make([]T, 0)
+	for iterator.Next() {
+		items = append(items, iterator.Item())
+	}
+
+	return items, iterator.Err()
+}
+
+func (iterator *MirrorIterator[T]) _FetchPage() error {
+	var page map[string]json.RawMessage
+	if err := iterator.mirror._Get(iterator.ctx, iterator.next, &page); err != nil {
+		return err
+	}
+
+	items := make([]T, 0)
+	if raw, ok := page[iterator.key]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+	}
+
+	var links MirrorLinks
+	if raw, ok := page["links"]; ok {
+		if err := json.Unmarshal(raw, &links); err != nil {
+			return err
+		}
+	}
+
+	iterator.items = items
+	iterator.next = ""
+	if links.Next != "" {
+		next, err := iterator.mirror._Resolve(links.Next)
+		if err != nil {
+			return err
+		}
+		iterator.next = next
+	}
+
+	return nil
+}
+
+func _MirrorHex(data []byte) string {
+	return "0x" + hex.EncodeToString(data)
+}
+
+// _MirrorHexDecode decodes the "0x" prefixed hex of the mirror node, which reports missing values as "0x" or nothing
+func _MirrorHexDecode(text string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(text, "0x"))
+}
+
+func _MirrorContractAddress(contractID ContractID) string {
+	if len(contractID.EvmAddress) > 0 {
+		return _MirrorHex(contractID.EvmAddress)
+	}
+
+	return "0x" + contractID.ToSolidityAddress()
+}
+
+// _MirrorEstimateGas estimates the gas of call with the client's mirror node and adds marginPercent percent to it
+func _MirrorEstimateGas(client *Client, call MirrorContractCall, marginPercent uint64) (uint64, error) {
+	if client == nil {
+		return 0, errNoClientProvided
+	}
+	if call.From == "" && client.operator != nil {
+		call.From = "0x" + client.operator.accountID.ToSolidityAddress()
+	}
+	call.Estimate = true
+
+	result, err := MirrorClientFromClient(client).CallContract(context.Background(), call)
+	if err != nil {
+		return 0, err
+	}
+
+	gas, err := strconv.ParseUint(strings.TrimPrefix(result.Result, "0x"), 16, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return gas + gas*marginPercent/100, nil
+}
@@ -0,0 +1,235 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitMirrorClientListAccountsPagination(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/accounts", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("account.id") {
+		case "":
+			require.Equal(t, "2", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`{"accounts":[{"account":"0.0.1","balance":{"balance":10}},{"account":"0.0.2","key":{"_type":"ED25519","key":"abcd"}}],"links":{"next":"/api/v1/accounts?limit=2&account.id=gt:0.0.2"}}`))
+		case "gt:0.0.2":
+			_, _ = w.Write([]byte(`{"accounts":[{"account":"0.0.3","staked_node_id":4,"created_timestamp":"1700000000.000000001"}],"links":{"next":null}}`))
+		default:
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}))
+	defer server.Close()
+
+	iterator := NewMirrorClient(server.URL).ListAccounts(context.Background(), url.Values{"limit": {"2"}})
+	accounts, err := iterator.All()
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	require.Equal(t, int64(10), accounts[0].Balance.Balance)
+	require.Equal(t, "ED25519", accounts[1].Key.Type)
+	require.Equal(t, int64(4), *accounts[2].StakedNodeID)
+	require.Equal(t, "", iterator.GetNextURL())
+
+	accountID, err := accounts[2].GetAccountID()
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 3}, accountID)
+
+	created, err := accounts[2].CreatedTimestamp.Time()
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000000, 1), created)
+}
+
+func TestUnitMirrorClientGetTransactions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/transactions/0.0.2-1700000000-000000123", r.URL.Path)
+		_, _ = w.Write([]byte(`{"transactions":[{"transaction_id":"0.0.2-1700000000-000000123","result":"SUCCESS","memo_base64":"aGk=","transfers":[{"account":"0.0.2","amount":-5},{"account":"0.0.3","amount":5}]}]}`))
+	}))
+	defer server.Close()
+
+	transactions, err := NewMirrorClient(server.URL+"/api/v1/").GetTransactions(context.Background(), "0.0.2@1700000000.000000123")
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, StatusSuccess, transactions[0].GetStatus())
+	require.Equal(t, "hi", transactions[0].GetMemo())
+	require.Len(t, transactions[0].Transfers, 2)
+}
+
+func TestUnitMirrorClientGetExchangeRate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/network/exchangerate", r.URL.Path)
+		_, _ = w.Write([]byte(`{"current_rate":{"cent_equivalent":12,"expiration_time":1700000000,"hbar_equivalent":1},"next_rate":{"cent_equivalent":13,"expiration_time":1700003600,"hbar_equivalent":1},"timestamp":"1699999999.000000000"}`))
+	}))
+	defer server.Close()
+
+	rates, err := NewMirrorClient(server.URL).GetExchangeRate(context.Background(), nil)
+	require.NoError(t, err)
+
+	exchangeRates := rates.ToExchangeRates()
+	require.Equal(t, int32(12), exchangeRates.Current.GetCents())
+	require.Equal(t, time.Unix(1700003600, 0), exchangeRates.Next.GetExpirationTime())
+}
+
+func TestUnitMirrorClientErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"Not found"}]}}`))
+	}))
+	defer server.Close()
+
+	mirror := NewMirrorClient(server.URL)
+
+	_, err := mirror.GetAccount(context.Background(), "0.0.404")
+	var mirrorErr ErrMirrorNodeResponse
+	require.ErrorAs(t, err, &mirrorErr)
+	require.Equal(t, http.StatusNotFound, mirrorErr.StatusCode)
+	require.Equal(t, []string{"Not found"}, mirrorErr.Messages)
+
+	iterator := mirror.ListTopicMessages(context.Background(), "0.0.5", nil)
+	require.False(t, iterator.Next())
+	require.ErrorAs(t, iterator.Err(), &mirrorErr)
+}
+
+func TestUnitMirrorTimestamp(t *testing.T) {
+	t.Parallel()
+
+	timestamp := MirrorTimestampFromTime(time.Unix(1700000000, 42))
+	require.Equal(t, MirrorTimestamp("1700000000.000000042"), timestamp)
+
+	parsed, err := timestamp.Time()
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000000, 42), parsed)
+
+	parsed, err = MirrorTimestamp("1700000000.5").Time()
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000000, 500000000), parsed)
+}
+
+func TestUnitMirrorClientPollsUntilIngested(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"account":"0.0.7"}`))
+	}))
+	defer server.Close()
+
+	mirror := NewMirrorClient(server.URL)
+
+	var account MirrorAccount
+	err := mirror._GetWithPolling(context.Background(), mirror._URL(nil, "accounts", "0.0.7"), &account)
+	require.Error(t, err)
+
+	mirror.SetPolling(time.Second, 10*time.Millisecond)
+	err = mirror._GetWithPolling(context.Background(), mirror._URL(nil, "accounts", "0.0.7"), &account)
+	require.NoError(t, err)
+	require.Equal(t, "0.0.7", account.Account)
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestUnitMirrorClientWaitForConsensusTimestamp(t *testing.T) {
+	t.Parallel()
+
+	target := time.Unix(1700000010, 0)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/blocks", r.URL.Path)
+		if atomic.AddInt32(&requests, 1) < 3 {
+			_, _ = w.Write([]byte(`{"blocks":[{"timestamp":{"from":"1700000000.000000000","to":"1700000002.000000000"}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"blocks":[{"timestamp":{"from":"1700000010.000000000","to":"1700000012.000000000"}}]}`))
+	}))
+	defer server.Close()
+
+	mirror := NewMirrorClient(server.URL)
+
+	err := mirror.WaitForConsensusTimestamp(context.Background(), target)
+	require.ErrorAs(t, err, &ErrMirrorNodeIngestionTimeout{})
+
+	mirror.SetPolling(time.Second, 10*time.Millisecond)
+	err = mirror.WaitForConsensusTimestamp(context.Background(), target)
+	require.NoError(t, err)
+}
+
+func TestUnitMirrorClientTokenRelationshipsWaitForConsensus(t *testing.T) {
+	t.Parallel()
+
+	requested := time.Unix(1700000010, 0)
+	var blockRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/blocks":
+			if atomic.AddInt32(&blockRequests, 1) < 3 {
+				_, _ = w.Write([]byte(`{"blocks":[{"timestamp":{"from":"1700000000.000000000","to":"1700000002.000000000"}}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"blocks":[{"timestamp":{"from":"1700000010.000000000","to":"1700000012.000000000"}}]}`))
+		case "/api/v1/accounts/1800/tokens":
+			// the stale rows are answered with a 200 until the mirror node catches up
+			balance := 1
+			if atomic.LoadInt32(&blockRequests) >= 3 {
+				balance = 5
+			}
+			_, _ = w.Write([]byte(`{"tokens":[{"token_id":"0.0.5","balance":` + strconv.Itoa(balance) + `,"decimals":2}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	balance := AccountBalance{TokenDecimals: TokenDecimalMap{decimals: make(map[string]uint64)}}
+	err := fetchTokenBalances(NewMirrorClient(server.URL), "1800", requested, &balance)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), balance.Tokens.Get(TokenID{Token: 5}))
+
+	mirror := NewMirrorClient(server.URL).SetPolling(time.Second, 10*time.Millisecond)
+	err = fetchTokenBalances(mirror, "1800", requested, &balance)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), balance.Tokens.Get(TokenID{Token: 5}))
+
+	err = fetchTokenBalances(mirror, "1800", requested.Add(time.Hour), &balance)
+	require.ErrorAs(t, err, &ErrMirrorNodeIngestionTimeout{})
+}
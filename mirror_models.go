@@ -0,0 +1,493 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// MirrorTimestamp is a consensus timestamp as formatted by the mirror node, "seconds.nanoseconds"
+type MirrorTimestamp string
+
+// Time returns the timestamp as a time.Time, or the zero time if it is empty
+func (timestamp MirrorTimestamp) Time() (time.Time, error) {
+	if timestamp == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, nanos := string(timestamp), "0"
+	if dot := strings.Index(seconds, "."); dot >= 0 {
+		seconds, nanos = seconds[:dot], seconds[dot+1:]
+	}
+	for len(nanos) < 9 {
+		nanos += "0"
+	}
+
+	s, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	n, err := strconv.ParseInt(nanos[:9], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(s, n), nil
+}
+
+// MirrorTimestampFromTime formats t the way the mirror node expects consensus timestamps
+func MirrorTimestampFromTime(t time.Time) MirrorTimestamp {
+	return MirrorTimestamp(strconv.FormatInt(t.Unix(), 10) + "." + _PadNanos(t.Nanosecond()))
+}
+
+func _PadNanos(nanos int) string {
+	str := strconv.Itoa(nanos)
+	for len(str) < 9 {
+		str = "0" + str
+	}
+
+	return str
+}
+
+// MirrorTimestampRange is the period for which a mirror node entity was valid
+type MirrorTimestampRange struct {
+	From MirrorTimestamp `json:"from"`
+	To   MirrorTimestamp `json:"to"`
+}
+
+// MirrorLinks holds the pagination links of a mirror node response
+type MirrorLinks struct {
+	Next string `json:"next"`
+}
+
+// MirrorKey is a key as returned by the mirror node
+type MirrorKey struct {
+	Type string `json:"_type"`
+	Key  string `json:"key"`
+}
+
+// MirrorTokenBalanceEntry is the balance of a single token held by an account
+type MirrorTokenBalanceEntry struct {
+	TokenID string `json:"token_id"`
+	Balance int64  `json:"balance"`
+}
+
+// MirrorAccountBalance is the balance of an account at a point in time
+type MirrorAccountBalance struct {
+	Balance   int64                     `json:"balance"`
+	Timestamp MirrorTimestamp           `json:"timestamp"`
+	Tokens    []MirrorTokenBalanceEntry `json:"tokens"`
+}
+
+// MirrorAccount is an account as returned by /accounts
+type MirrorAccount struct {
+	Account                       string               `json:"account"`
+	Alias                         string               `json:"alias"`
+	AutoRenewPeriod               int64                `json:"auto_renew_period"`
+	Balance                       MirrorAccountBalance `json:"balance"`
+	CreatedTimestamp              MirrorTimestamp      `json:"created_timestamp"`
+	DeclineReward                 bool                 `json:"decline_reward"`
+	Deleted                       bool                 `json:"deleted"`
+	EthereumNonce                 int64                `json:"ethereum_nonce"`
+	EvmAddress                    string               `json:"evm_address"`
+	ExpiryTimestamp               MirrorTimestamp      `json:"expiry_timestamp"`
+	Key                           *MirrorKey           `json:"key"`
+	MaxAutomaticTokenAssociations int32                `json:"max_automatic_token_associations"`
+	Memo                          string               `json:"memo"`
+	PendingReward                 int64                `json:"pending_reward"`
+	ReceiverSigRequired           bool                 `json:"receiver_sig_required"`
+	StakedAccountID               string               `json:"staked_account_id"`
+	StakedNodeID                  *int64               `json:"staked_node_id"`
+	StakePeriodStart              MirrorTimestamp      `json:"stake_period_start"`
+}
+
+// GetAccountID parses the account's ID
+func (account MirrorAccount) GetAccountID() (AccountID, error) {
+	return AccountIDFromString(account.Account)
+}
+
+// MirrorNft is an NFT as returned by /accounts/{id}/nfts and /tokens/{id}/nfts
+type MirrorNft struct {
+	AccountID         string          `json:"account_id"`
+	CreatedTimestamp  MirrorTimestamp `json:"created_timestamp"`
+	DelegatingSpender string          `json:"delegating_spender"`
+	Deleted           bool            `json:"deleted"`
+	Metadata          []byte          `json:"metadata"`
+	ModifiedTimestamp MirrorTimestamp `json:"modified_timestamp"`
+	SerialNumber      int64           `json:"serial_number"`
+	Spender           string          `json:"spender"`
+	TokenID           string          `json:"token_id"`
+}
+
+// GetNftID parses the NFT's ID
+func (nft MirrorNft) GetNftID() (NftID, error) {
+	tokenID, err := TokenIDFromString(nft.TokenID)
+	if err != nil {
+		return NftID{}, err
+	}
+
+	return NftID{TokenID: tokenID, SerialNumber: nft.SerialNumber}, nil
+}
+
+// MirrorCryptoAllowance is an hbar allowance as returned by /accounts/{id}/allowances/crypto
+type MirrorCryptoAllowance struct {
+	Amount        int64                `json:"amount"`
+	AmountGranted int64                `json:"amount_granted"`
+	Owner         string               `json:"owner"`
+	Spender       string               `json:"spender"`
+	Timestamp     MirrorTimestampRange `json:"timestamp"`
+}
+
+// MirrorTokenAllowance is a fungible token allowance as returned by /accounts/{id}/allowances/tokens
+type MirrorTokenAllowance struct {
+	Amount        int64                `json:"amount"`
+	AmountGranted int64                `json:"amount_granted"`
+	Owner         string               `json:"owner"`
+	Spender       string               `json:"spender"`
+	TokenID       string               `json:"token_id"`
+	Timestamp     MirrorTimestampRange `json:"timestamp"`
+}
+
+// MirrorNftAllowance is an NFT allowance as returned by /accounts/{id}/allowances/nfts
+type MirrorNftAllowance struct {
+	ApprovedForAll bool                 `json:"approved_for_all"`
+	Owner          string               `json:"owner"`
+	Spender        string               `json:"spender"`
+	TokenID        string               `json:"token_id"`
+	Timestamp      MirrorTimestampRange `json:"timestamp"`
+}
+
+// MirrorStakingReward is a staking reward as returned by /accounts/{id}/rewards
+type MirrorStakingReward struct {
+	AccountID string          `json:"account_id"`
+	Amount    int64           `json:"amount"`
+	Timestamp MirrorTimestamp `json:"timestamp"`
+}
+
+// MirrorTransfer is an hbar transfer of a MirrorTransaction
+type MirrorTransfer struct {
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// MirrorTokenTransfer is a fungible token transfer of a MirrorTransaction
+type MirrorTokenTransfer struct {
+	TokenID    string `json:"token_id"`
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// MirrorNftTransfer is an NFT transfer of a MirrorTransaction
+type MirrorNftTransfer struct {
+	IsApproval        bool   `json:"is_approval"`
+	ReceiverAccountID string `json:"receiver_account_id"`
+	SenderAccountID   string `json:"sender_account_id"`
+	SerialNumber      int64  `json:"serial_number"`
+	TokenID           string `json:"token_id"`
+}
+
+// MirrorAssessedCustomFee is a custom fee charged by a MirrorTransaction
+type MirrorAssessedCustomFee struct {
+	Amount                   int64    `json:"amount"`
+	CollectorAccountID       string   `json:"collector_account_id"`
+	EffectivePayerAccountIDs []string `json:"effective_payer_account_ids"`
+	TokenID                  string   `json:"token_id"`
+}
+
+// MirrorTransaction is a transaction as returned by /transactions
+type MirrorTransaction struct {
+	AssessedCustomFees       []MirrorAssessedCustomFee `json:"assessed_custom_fees"`
+	Bytes                    []byte                    `json:"bytes"`
+	ChargedTxFee             int64                     `json:"charged_tx_fee"`
+	ConsensusTimestamp       MirrorTimestamp           `json:"consensus_timestamp"`
+	EntityID                 string                    `json:"entity_id"`
+	MaxFee                   string                    `json:"max_fee"`
+	MemoBase64               []byte                    `json:"memo_base64"`
+	Name                     string                    `json:"name"`
+	NftTransfers             []MirrorNftTransfer       `json:"nft_transfers"`
+	Node                     string                    `json:"node"`
+	Nonce                    int32                     `json:"nonce"`
+	ParentConsensusTimestamp MirrorTimestamp           `json:"parent_consensus_timestamp"`
+	Result                   string                    `json:"result"`
+	Scheduled                bool                      `json:"scheduled"`
+	StakingRewardTransfers   []MirrorTransfer          `json:"staking_reward_transfers"`
+	TokenTransfers           []MirrorTokenTransfer     `json:"token_transfers"`
+	TransactionHash          []byte                    `json:"transaction_hash"`
+	TransactionID            string                    `json:"transaction_id"`
+	Transfers                []MirrorTransfer          `json:"transfers"`
+	ValidDurationSeconds     string                    `json:"valid_duration_seconds"`
+	ValidStartTimestamp      MirrorTimestamp           `json:"valid_start_timestamp"`
+}
+
+// GetMemo returns the decoded memo of the transaction
+func (transaction MirrorTransaction) GetMemo() string {
+	return string(transaction.MemoBase64)
+}
+
+// GetStatus returns the result of the transaction as a Status, or StatusUnknown if it is not recognized
+func (transaction MirrorTransaction) GetStatus() Status {
+	return _StatusFromName(transaction.Result)
+}
+
+func _StatusFromName(name string) Status {
+	if code, ok := services.ResponseCodeEnum_value[name]; ok {
+		return Status(code)
+	}
+
+	return StatusUnknown
+}
+
+// MirrorToken is a token as returned by /tokens
+type MirrorToken struct {
+	AdminKey *MirrorKey `json:"admin_key"`
+	Decimals int64      `json:"decimals"`
+	Metadata []byte     `json:"metadata"`
+	Name     string     `json:"name"`
+	Symbol   string     `json:"symbol"`
+	TokenID  string     `json:"token_id"`
+	Type     string     `json:"type"`
+}
+
+// MirrorTokenBalance is the balance of an account as returned by /tokens/{id}/balances
+type MirrorTokenBalance struct {
+	Account  string `json:"account"`
+	Balance  int64  `json:"balance"`
+	Decimals int64  `json:"decimals"`
+}
+
+// MirrorTransactionIDInfo identifies the transaction which submitted the first chunk of a message
+type MirrorTransactionIDInfo struct {
+	AccountID             string          `json:"account_id"`
+	Nonce                 int32           `json:"nonce"`
+	Scheduled             bool            `json:"scheduled"`
+	TransactionValidStart MirrorTimestamp `json:"transaction_valid_start"`
+}
+
+// MirrorChunkInfo describes the position of a chunk within a chunked topic message
+type MirrorChunkInfo struct {
+	InitialTransactionID MirrorTransactionIDInfo `json:"initial_transaction_id"`
+	Number               int32                   `json:"number"`
+	Total                int32                   `json:"total"`
+}
+
+// MirrorTopicMessage is a topic message as returned by /topics/{id}/messages
+type MirrorTopicMessage struct {
+	ChunkInfo          *MirrorChunkInfo `json:"chunk_info"`
+	ConsensusTimestamp MirrorTimestamp  `json:"consensus_timestamp"`
+	Message            []byte           `json:"message"`
+	PayerAccountID     string           `json:"payer_account_id"`
+	RunningHash        []byte           `json:"running_hash"`
+	RunningHashVersion int32            `json:"running_hash_version"`
+	SequenceNumber     uint64           `json:"sequence_number"`
+	TopicID            string           `json:"topic_id"`
+}
+
+// MirrorContract is a contract as returned by /contracts/{idOrAddress}
+type MirrorContract struct {
+	AdminKey            *MirrorKey      `json:"admin_key"`
+	AutoRenewAccount    string          `json:"auto_renew_account"`
+	AutoRenewPeriod     int64           `json:"auto_renew_period"`
+	ContractID          string          `json:"contract_id"`
+	CreatedTimestamp    MirrorTimestamp `json:"created_timestamp"`
+	Deleted             bool            `json:"deleted"`
+	EvmAddress          string          `json:"evm_address"`
+	ExpirationTimestamp MirrorTimestamp `json:"expiration_timestamp"`
+	FileID              string          `json:"file_id"`
+	Memo                string          `json:"memo"`
+	Nonce               int64           `json:"nonce"`
+}
+
+// GetContractID returns the ID of the contract
+func (contract MirrorContract) GetContractID() (ContractID, error) {
+	return ContractIDFromString(contract.ContractID)
+}
+
+// MirrorContractResult is the result of a contract call as returned by /contracts/results
+type MirrorContractResult struct {
+	Address            string                      `json:"address"`
+	Amount             int64                       `json:"amount"`
+	BlockHash          string                      `json:"block_hash"`
+	BlockNumber        int64                       `json:"block_number"`
+	Bloom              string                      `json:"bloom"`
+	CallResult         string                      `json:"call_result"`
+	ContractID         string                      `json:"contract_id"`
+	CreatedContractIDs []string                    `json:"created_contract_ids"`
+	ErrorMessage       string                      `json:"error_message"`
+	From               string                      `json:"from"`
+	FunctionParameters string                      `json:"function_parameters"`
+	GasConsumed        int64                       `json:"gas_consumed"`
+	GasLimit           int64                       `json:"gas_limit"`
+	GasUsed            int64                       `json:"gas_used"`
+	Hash               string                      `json:"hash"`
+	Result             string                      `json:"result"`
+	StateChanges       []MirrorContractStateChange `json:"state_changes"`
+	Status             string                      `json:"status"`
+	Timestamp          MirrorTimestamp             `json:"timestamp"`
+	To                 string                      `json:"to"`
+}
+
+// MirrorContractStateChange is a storage slot a contract result read or wrote. ValueWritten is empty when the
+// slot was only read.
+type MirrorContractStateChange struct {
+	Address      string `json:"address"`
+	ContractID   string `json:"contract_id"`
+	Slot         string `json:"slot"`
+	ValueRead    string `json:"value_read"`
+	ValueWritten string `json:"value_written"`
+}
+
+// MirrorContractLog is a log emitted by a contract as returned by /contracts/{id}/results/logs
+type MirrorContractLog struct {
+	Address          string          `json:"address"`
+	BlockHash        string          `json:"block_hash"`
+	BlockNumber      int64           `json:"block_number"`
+	Bloom            string          `json:"bloom"`
+	ContractID       string          `json:"contract_id"`
+	Data             string          `json:"data"`
+	Index            int64           `json:"index"`
+	RootContractID   string          `json:"root_contract_id"`
+	Timestamp        MirrorTimestamp `json:"timestamp"`
+	Topics           []string        `json:"topics"`
+	TransactionHash  string          `json:"transaction_hash"`
+	TransactionIndex int64           `json:"transaction_index"`
+}
+
+// MirrorScheduleSignature is a signature collected by a schedule
+type MirrorScheduleSignature struct {
+	ConsensusTimestamp MirrorTimestamp `json:"consensus_timestamp"`
+	PublicKeyPrefix    []byte          `json:"public_key_prefix"`
+	Signature          []byte          `json:"signature"`
+	Type               string          `json:"type"`
+}
+
+// MirrorSchedule is a schedule as returned by /schedules
+type MirrorSchedule struct {
+	AdminKey           *MirrorKey                `json:"admin_key"`
+	ConsensusTimestamp MirrorTimestamp           `json:"consensus_timestamp"`
+	CreatorAccountID   string                    `json:"creator_account_id"`
+	Deleted            bool                      `json:"deleted"`
+	ExecutedTimestamp  MirrorTimestamp           `json:"executed_timestamp"`
+	ExpirationTime     MirrorTimestamp           `json:"expiration_time"`
+	Memo               string                    `json:"memo"`
+	PayerAccountID     string                    `json:"payer_account_id"`
+	ScheduleID         string                    `json:"schedule_id"`
+	Signatures         []MirrorScheduleSignature `json:"signatures"`
+	TransactionBody    []byte                    `json:"transaction_body"`
+	WaitForExpiry      bool                      `json:"wait_for_expiry"`
+}
+
+// MirrorServiceEndpoint is an endpoint of a MirrorNetworkNode
+type MirrorServiceEndpoint struct {
+	DomainName  string `json:"domain_name"`
+	IPAddressV4 string `json:"ip_address_v4"`
+	Port        int32  `json:"port"`
+}
+
+// MirrorNetworkNode is a consensus node as returned by /network/nodes
+type MirrorNetworkNode struct {
+	Description      string                  `json:"description"`
+	FileID           string                  `json:"file_id"`
+	MaxStake         int64                   `json:"max_stake"`
+	Memo             string                  `json:"memo"`
+	MinStake         int64                   `json:"min_stake"`
+	NodeAccountID    string                  `json:"node_account_id"`
+	NodeCertHash     string                  `json:"node_cert_hash"`
+	NodeID           int64                   `json:"node_id"`
+	PublicKey        string                  `json:"public_key"`
+	RewardRateStart  int64                   `json:"reward_rate_start"`
+	ServiceEndpoints []MirrorServiceEndpoint `json:"service_endpoints"`
+	Stake            int64                   `json:"stake"`
+	StakeNotRewarded int64                   `json:"stake_not_rewarded"`
+	StakeRewarded    int64                   `json:"stake_rewarded"`
+	Timestamp        MirrorTimestampRange    `json:"timestamp"`
+}
+
+// MirrorNetworkSupply is the hbar supply as returned by /network/supply. The amounts are in tinybar
+// and formatted as decimal strings as they may not fit in an int64.
+type MirrorNetworkSupply struct {
+	ReleasedSupply string          `json:"released_supply"`
+	Timestamp      MirrorTimestamp `json:"timestamp"`
+	TotalSupply    string          `json:"total_supply"`
+}
+
+// MirrorNetworkFee is the gas price of a transaction type
+type MirrorNetworkFee struct {
+	Gas             int64  `json:"gas"`
+	TransactionType string `json:"transaction_type"`
+}
+
+// MirrorNetworkFees is the network fees as returned by /network/fees
+type MirrorNetworkFees struct {
+	Fees      []MirrorNetworkFee `json:"fees"`
+	Timestamp MirrorTimestamp    `json:"timestamp"`
+}
+
+// MirrorExchangeRate is an exchange rate as returned by /network/exchangerate
+type MirrorExchangeRate struct {
+	CentEquivalent int32 `json:"cent_equivalent"`
+	ExpirationTime int64 `json:"expiration_time"`
+	HbarEquivalent int32 `json:"hbar_equivalent"`
+}
+
+// MirrorExchangeRateSet is the current and next exchange rate as returned by /network/exchangerate
+type MirrorExchangeRateSet struct {
+	CurrentRate MirrorExchangeRate `json:"current_rate"`
+	NextRate    MirrorExchangeRate `json:"next_rate"`
+	Timestamp   MirrorTimestamp    `json:"timestamp"`
+}
+
+// ToExchangeRates converts the rates into the type decoded from the exchange rate system file
+func (rates MirrorExchangeRateSet) ToExchangeRates() ExchangeRates {
+	return ExchangeRates{
+		Current: NewExchangeRate(rates.CurrentRate.HbarEquivalent, rates.CurrentRate.CentEquivalent, time.Unix(rates.CurrentRate.ExpirationTime, 0)),
+		Next:    NewExchangeRate(rates.NextRate.HbarEquivalent, rates.NextRate.CentEquivalent, time.Unix(rates.NextRate.ExpirationTime, 0)),
+	}
+}
+
+// MirrorContractCall is the request of a contract call simulated by the mirror node. Addresses and
+// data are hex encoded with a "0x" prefix.
+type MirrorContractCall struct {
+	// The block to run the call against: "latest", "earliest", "pending" or a block number. Defaults to "latest".
+	Block string `json:"block,omitempty"`
+	// The encoded function selector and parameters, or the bytecode of a contract to deploy
+	Data string `json:"data,omitempty"`
+	// Whether to estimate the gas of the call instead of returning its result
+	Estimate bool   `json:"estimate"`
+	From     string `json:"from,omitempty"`
+	Gas      int64  `json:"gas,omitempty"`
+	GasPrice int64  `json:"gasPrice,omitempty"`
+	// The contract to call, or empty to simulate a deployment
+	To string `json:"to,omitempty"`
+	// The tinybar sent along with the call
+	Value int64 `json:"value,omitempty"`
+}
+
+// MirrorContractCallResult is the response to a MirrorContractCall
+type MirrorContractCallResult struct {
+	// The hex encoded result of the call, or the gas estimate as a hex number
+	Result string `json:"result"`
+}
@@ -65,3 +65,25 @@ func (network *_MirrorNetwork) _GetNextMirrorNode() *_MirrorNode {
 	}
 	return &_MirrorNode{}
 }
+
+// _GetMirrorNodes returns every mirror node, starting from a random one so that subscriptions failing
+// over between them spread their load
+func (network *_MirrorNetwork) _GetMirrorNodes() []*_MirrorNode {
+	network.healthyNodesMutex.RLock()
+	defer network.healthyNodesMutex.RUnlock()
+
+	nodes := make([]*_MirrorNode, 0, len(network.nodes))
+	for _, node := range network.nodes {
+		if node, ok := node.(*_MirrorNode); ok {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return nodes
+	}
+
+	start := rand.Intn(len(nodes)) // nolint
+	rotated := make([]*_MirrorNode, 0, len(nodes))
+	rotated = append(rotated, nodes[start:]...)
+	return append(rotated, nodes[:start]...)
+}
@@ -21,9 +21,7 @@ package hedera
  */
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
+	"context"
 	"strings"
 	"time"
 )
@@ -33,26 +31,26 @@ const API_VERSION = "/api/v1"
 const PORT = ":5551"
 
 // Function to obtain the token relationships of the specified account
-func tokenRelationshipMirrorNodeQuery(networkUrl string, id string) (map[string]interface{}, error) {
-	tokenRelationshipUrl := buildUrlParams(networkUrl, "accounts", id, "tokens")
-	return makeGetRequest(tokenRelationshipUrl)
+func tokenRelationshipMirrorNodeQuery(mirror *MirrorClient, id string, consensusTimestamp time.Time) (map[string]interface{}, error) {
+	tokenRelationshipUrl := buildUrlParams(mirror.GetBaseURL(), "accounts", id, "tokens")
+	return makeGetRequest(mirror, tokenRelationshipUrl, consensusTimestamp)
 }
 
 // Function to obtain account info for given account ID. Return the pure JSON response as mapping
-func accountInfoMirrorNodeQuery(networkUrl string, accountId string) (map[string]interface{}, error) { // nolint
-	accountInfoUrl := buildUrlParams(networkUrl, "accounts", accountId)
-	return makeGetRequest(accountInfoUrl)
+func accountInfoMirrorNodeQuery(mirror *MirrorClient, accountId string, consensusTimestamp time.Time) (map[string]interface{}, error) { // nolint
+	accountInfoUrl := buildUrlParams(mirror.GetBaseURL(), "accounts", accountId)
+	return makeGetRequest(mirror, accountInfoUrl, consensusTimestamp)
 }
 
 // Function to obtain balance of tokens for given contract ID. Return the pure JSON response as mapping
-func contractInfoMirrorNodeQuery(networkUrl string, contractId string) (map[string]interface{}, error) { // nolint
-	contractInfoUrl := buildUrlParams(networkUrl, "contracts", contractId)
-	return makeGetRequest(contractInfoUrl)
+func contractInfoMirrorNodeQuery(mirror *MirrorClient, contractId string, consensusTimestamp time.Time) (map[string]interface{}, error) { // nolint
+	contractInfoUrl := buildUrlParams(mirror.GetBaseURL(), "contracts", contractId)
+	return makeGetRequest(mirror, contractInfoUrl, consensusTimestamp)
 }
 
 // Function to obtain balance of tokens for given account ID. Return the pure JSON response as mapping
-func accountTokenBalanceMirrorNodeQuery(networkUrl string, accountId string) (map[string]interface{}, error) {
-	info, err := tokenRelationshipMirrorNodeQuery(networkUrl, accountId)
+func accountTokenBalanceMirrorNodeQuery(mirror *MirrorClient, accountId string, consensusTimestamp time.Time) (map[string]interface{}, error) {
+	info, err := tokenRelationshipMirrorNodeQuery(mirror, accountId, consensusTimestamp)
 
 	// in case of empty info we won't be able to map to string interface
 	if len(info) == 0 {
@@ -61,8 +59,16 @@ func accountTokenBalanceMirrorNodeQuery(networkUrl string, accountId string) (ma
 	return info, err
 }
 
-// Function to deduce the current network from the client as the network is ambiguous during Mirror Node calls
+// Function to deduce the mirror node REST URL from the client. An explicitly configured URL wins,
+// otherwise it is derived from the first mirror network address, as the network is ambiguous during Mirror Node calls
 func fetchMirrorNodeUrlFromClient(client *Client) string {
+	if client.mirrorRestURL != "" {
+		return client.mirrorRestURL
+	}
+	if len(client.GetMirrorNetwork()) == 0 {
+		return ""
+	}
+
 	if strings.HasPrefix(client.GetMirrorNetwork()[0], LOCAL_NETWORK) {
 		return "http://" + LOCAL_NETWORK + PORT + API_VERSION
 	} else {
@@ -72,27 +78,21 @@ func fetchMirrorNodeUrlFromClient(client *Client) string {
 }
 
 // Make a GET HTTP request to provided URL and map it's JSON response to a generic `interface` map and return it
-func makeGetRequest(networkUrl string) (response map[string]interface{}, e error) {
-	// This is needed because of Mirror Node update delay time
-	// Timeout for every query when using local network, for CI and testing consistency
-	if strings.Contains(networkUrl, LOCAL_NETWORK) {
-		time.Sleep(4 * time.Second)
-	}
-
-	// Make an HTTP request
-	resp, err := http.Get(networkUrl) //nolint
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// once the mirror node has ingested consensus up to consensusTimestamp. A zero timestamp skips the wait.
+func makeGetRequest(mirror *MirrorClient, networkUrl string, consensusTimestamp time.Time) (response map[string]interface{}, e error) {
+	ctx := context.Background()
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("HTTP request failed with status code: %d", resp.StatusCode)
+	// The mirror node lags behind the network and answers with stale data rather than an error, so when
+	// polling is enabled wait until it has caught up with the state the caller saw on the network
+	if mirror.GetPollingTimeout() > 0 && !consensusTimestamp.IsZero() {
+		if err := mirror.WaitForConsensusTimestamp(ctx, consensusTimestamp); err != nil {
+			return nil, err
+		}
 	}
 
-	// Decode the JSON response into a map
+	// Entities the mirror node hasn't ingested yet are polled for
 	var responseMap map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&responseMap)
+	err := mirror._GetWithPolling(ctx, networkUrl, &responseMap)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,496 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WatchEventType is the kind of change a WatchEvent reports
+type WatchEventType int
+
+const (
+	// WatchEventHbarReceived is an hbar transfer credited to the account
+	WatchEventHbarReceived WatchEventType = iota
+	// WatchEventHbarSent is an hbar transfer debited from the account, including transaction fees
+	WatchEventHbarSent
+	// WatchEventTokenTransfer is a fungible token transfer to or from the account
+	WatchEventTokenTransfer
+	// WatchEventNftTransfer is an NFT changing hands, being minted or being burned
+	WatchEventNftTransfer
+	// WatchEventAllowanceChange is an allowance approved or deleted by the account
+	WatchEventAllowanceChange
+)
+
+// String returns the name of the event type
+func (eventType WatchEventType) String() string {
+	switch eventType {
+	case WatchEventHbarReceived:
+		return "HBAR_RECEIVED"
+	case WatchEventHbarSent:
+		return "HBAR_SENT"
+	case WatchEventTokenTransfer:
+		return "TOKEN_TRANSFER"
+	case WatchEventNftTransfer:
+		return "NFT_TRANSFER"
+	case WatchEventAllowanceChange:
+		return "ALLOWANCE_CHANGE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// WatchEvent is a change to an account or token found by MirrorWatcher
+type WatchEvent struct {
+	Type               WatchEventType
+	ConsensusTimestamp time.Time
+	// The ID of the transaction as formatted by the mirror node, such as "0.0.2-1700000000-000000001"
+	TransactionID string
+	// The account whose balance changed, or which changed its allowances
+	AccountID AccountID
+	// The hbar credited to or debited from AccountID, for hbar events
+	Hbar Hbar
+	// The token of token and NFT transfers
+	TokenID *TokenID
+	// The amount of the token credited to, when positive, or debited from AccountID, for token transfers
+	Amount int64
+	// The NFT of NFT transfers
+	NftID *NftID
+	// The previous owner of the NFT, or nil if it was minted
+	SenderAccountID *AccountID
+	// The new owner of the NFT, or nil if it was burned
+	ReceiverAccountID *AccountID
+	// The transaction the event was found in
+	Transaction MirrorTransaction
+}
+
+// WatchCursor is the position of a watcher: the consensus timestamp of the transaction of the last handled event,
+// and the index of that event among the events of the transaction
+type WatchCursor struct {
+	ConsensusTimestamp time.Time
+	Event              int
+}
+
+// WatchCursorStore persists the position of watchers, so that a restarted watcher continues with the event following
+// the last handled one.
+type WatchCursorStore interface {
+	// Load returns the cursor saved under key, or nil if there is none
+	Load(key string) (*WatchCursor, error)
+	// Save records the cursor under key, replacing the previous one
+	Save(key string, cursor WatchCursor) error
+}
+
+// MemoryWatchCursorStore is a WatchCursorStore kept in memory
+type MemoryWatchCursorStore struct {
+	cursors map[string]WatchCursor
+	mu      sync.Mutex
+}
+
+// NewMemoryWatchCursorStore creates an empty MemoryWatchCursorStore
+func NewMemoryWatchCursorStore() *MemoryWatchCursorStore {
+	return &MemoryWatchCursorStore{
+		cursors: make(map[string]WatchCursor),
+	}
+}
+
+// Load returns the cursor saved under key, or nil if there is none
+func (store *MemoryWatchCursorStore) Load(key string) (*WatchCursor, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	cursor, ok := store.cursors[key]
+	if !ok {
+		return nil, nil
+	}
+
+	return &cursor, nil
+}
+
+// Save records the cursor under key, replacing the previous one
+func (store *MemoryWatchCursorStore) Save(key string, cursor WatchCursor) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.cursors[key] = cursor
+	return nil
+}
+
+// FileWatchCursorStore is a WatchCursorStore persisting the cursors of every watcher as JSON in a single file
+type FileWatchCursorStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+type _FileWatchCursor struct {
+	Seconds int64 `json:"seconds"`
+	Nanos   int64 `json:"nanos"`
+	Event   int   `json:"event"`
+}
+
+// NewFileWatchCursorStore creates a FileWatchCursorStore backed by the file at path, which is created on the first save
+func NewFileWatchCursorStore(path string) *FileWatchCursorStore {
+	return &FileWatchCursorStore{
+		path: path,
+	}
+}
+
+// Load returns the cursor saved under key, or nil if there is none
+func (store *FileWatchCursorStore) Load(key string) (*WatchCursor, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	cursors := make(map[string]_FileWatchCursor)
+	if err := _ReadJSONFile(store.path, &cursors); err != nil {
+		return nil, err
+	}
+
+	cursor, ok := cursors[key]
+	if !ok {
+		return nil, nil
+	}
+
+	return &WatchCursor{
+		ConsensusTimestamp: time.Unix(cursor.Seconds, cursor.Nanos),
+		Event:              cursor.Event,
+	}, nil
+}
+
+// Save records the cursor under key, replacing the previous one
+func (store *FileWatchCursorStore) Save(key string, cursor WatchCursor) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	cursors := make(map[string]_FileWatchCursor)
+	if err := _ReadJSONFile(store.path, &cursors); err != nil {
+		return err
+	}
+
+	cursors[key] = _FileWatchCursor{
+		Seconds: cursor.ConsensusTimestamp.Unix(),
+		Nanos:   int64(cursor.ConsensusTimestamp.Nanosecond()),
+		Event:   cursor.Event,
+	}
+
+	return _WriteJSONFile(store.path, cursors)
+}
+
+// MirrorWatcher reports changes to accounts and tokens by polling the transactions of the mirror node REST API.
+//
+// With a cursor store, the position of the watcher is saved after every handled event, so a restarted watcher
+// continues right after it. An event is only handled again if the process stops after its handler returned but
+// before the cursor was saved.
+type MirrorWatcher struct {
+	client       *Client
+	cursorStore  WatchCursorStore
+	pollInterval time.Duration
+	startTime    *time.Time
+}
+
+// NewMirrorWatcher creates a MirrorWatcher using the mirror node of client
+func NewMirrorWatcher(client *Client) *MirrorWatcher {
+	return &MirrorWatcher{
+		client:       client,
+		pollInterval: 2 * time.Second,
+	}
+}
+
+// SetCursorStore sets the store the position of the watcher is saved to after every handled event
+func (watcher *MirrorWatcher) SetCursorStore(store WatchCursorStore) *MirrorWatcher {
+	watcher.cursorStore = store
+	return watcher
+}
+
+// GetCursorStore returns the store the position of the watcher is saved to
+func (watcher *MirrorWatcher) GetCursorStore() WatchCursorStore {
+	return watcher.cursorStore
+}
+
+// SetPollInterval sets the time to wait before asking the mirror node for new transactions once it returned all of
+// them. Defaults to 2 seconds.
+func (watcher *MirrorWatcher) SetPollInterval(interval time.Duration) *MirrorWatcher {
+	watcher.pollInterval = interval
+	return watcher
+}
+
+// GetPollInterval returns the time to wait before asking the mirror node for new transactions
+func (watcher *MirrorWatcher) GetPollInterval() time.Duration {
+	return watcher.pollInterval
+}
+
+// SetStartTime sets the consensus time to start watching at when the cursor store holds no cursor. Defaults to the
+// time watching starts, reporting only new changes.
+func (watcher *MirrorWatcher) SetStartTime(startTime time.Time) *MirrorWatcher {
+	watcher.startTime = &startTime
+	return watcher
+}
+
+// GetStartTime returns the consensus time to start watching at when the cursor store holds no cursor
+func (watcher *MirrorWatcher) GetStartTime() time.Time {
+	if watcher.startTime != nil {
+		return *watcher.startTime
+	}
+
+	return time.Time{}
+}
+
+// WatchAccount passes the hbar, token, NFT and allowance changes of the account to onEvent, in consensus order, until
+// ctx is done or onEvent fails. The account must be given by its number, not an alias. Only successful transactions
+// are reported. An event whose handler fails isn't saved to
+// the cursor store, so it is reported again when watching restarts. It returns the error of ctx, of onEvent or of the
+// cursor store.
+func (watcher *MirrorWatcher) WatchAccount(ctx context.Context, accountID AccountID, onEvent func(WatchEvent) error) error {
+	accountID = AccountID{Shard: accountID.Shard, Realm: accountID.Realm, Account: accountID.Account}
+
+	params := url.Values{}
+	params.Set("account.id", accountID.String())
+
+	return watcher._Watch(ctx, "account:"+accountID.String(), params, func(transaction MirrorTransaction) []WatchEvent {
+		return _AccountWatchEvents(accountID.String(), transaction)
+	}, onEvent)
+}
+
+// WatchToken passes the transfers of the token to onEvent, in consensus order, until ctx is done or onEvent fails.
+// It behaves like WatchAccount, but as the mirror node REST API can't filter transactions by token, it reads every
+// transaction of the network and filters them itself. That is fine for local networks and quiet test networks, but
+// can't keep up with the transaction volume of mainnet; there, watch the token through the accounts holding it, such
+// as its treasury, with WatchTokenOfAccount.
+func (watcher *MirrorWatcher) WatchToken(ctx context.Context, tokenID TokenID, onEvent func(WatchEvent) error) error {
+	tokenID = TokenID{Shard: tokenID.Shard, Realm: tokenID.Realm, Token: tokenID.Token}
+
+	return watcher._Watch(ctx, "token:"+tokenID.String(), url.Values{}, func(transaction MirrorTransaction) []WatchEvent {
+		return _TokenWatchEvents(tokenID.String(), transaction)
+	}, onEvent)
+}
+
+// WatchTokenOfAccount passes the transfers of the token to or from the account to onEvent, in consensus order, until
+// ctx is done or onEvent fails. Unlike WatchToken, it only reads the transactions of the account, so it keeps up with
+// any network. Watching the treasury of a token reports its mints, burns and distributions.
+func (watcher *MirrorWatcher) WatchTokenOfAccount(ctx context.Context, tokenID TokenID, accountID AccountID, onEvent func(WatchEvent) error) error {
+	tokenID = TokenID{Shard: tokenID.Shard, Realm: tokenID.Realm, Token: tokenID.Token}
+	accountID = AccountID{Shard: accountID.Shard, Realm: accountID.Realm, Account: accountID.Account}
+
+	params := url.Values{}
+	params.Set("account.id", accountID.String())
+
+	return watcher._Watch(ctx, "token:"+tokenID.String()+":account:"+accountID.String(), params, func(transaction MirrorTransaction) []WatchEvent {
+		events := make([]WatchEvent, 0)
+		for _, event := range _AccountWatchEvents(accountID.String(), transaction) {
+			if event.TokenID != nil && event.TokenID.Compare(tokenID) == 0 {
+				events = append(events, event)
+			}
+		}
+		return events
+	}, onEvent)
+}
+
+func (watcher *MirrorWatcher) _Watch(ctx context.Context, key string, params url.Values, events func(MirrorTransaction) []WatchEvent, onEvent func(WatchEvent) error) error {
+	if watcher.client == nil {
+		return errNoClientProvided
+	}
+
+	cursor := WatchCursor{ConsensusTimestamp: time.Now(), Event: -1}
+	if watcher.startTime != nil {
+		cursor.ConsensusTimestamp = *watcher.startTime
+	}
+
+	if watcher.cursorStore != nil {
+		saved, err := watcher.cursorStore.Load(key)
+		if err != nil {
+			return err
+		}
+		if saved != nil {
+			cursor = *saved
+		}
+	}
+
+	// The transactions before position have been looked at, which may be well past the cursor when they had no events
+	position := cursor.ConsensusTimestamp
+	mirror := MirrorClientFromClient(watcher.client)
+
+	for {
+		page := url.Values{}
+		for name, values := range params {
+			page[name] = values
+		}
+		page.Set("order", "asc")
+		page.Set("limit", "100")
+		page.Set("timestamp", "gte:"+string(MirrorTimestampFromTime(position)))
+
+		iterator := mirror.ListTransactions(ctx, page)
+		for iterator.Next() {
+			transaction := iterator.Item()
+
+			timestamp, err := transaction.ConsensusTimestamp.Time()
+			if err != nil {
+				return err
+			}
+			position = timestamp
+
+			if transaction.Result != "SUCCESS" {
+				continue
+			}
+
+			for index, event := range events(transaction) {
+				if timestamp.Equal(cursor.ConsensusTimestamp) && index <= cursor.Event {
+					continue
+				}
+
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				if err := onEvent(event); err != nil {
+					return err
+				}
+
+				cursor = WatchCursor{ConsensusTimestamp: timestamp, Event: index}
+				if watcher.cursorStore != nil {
+					if err := watcher.cursorStore.Save(key, cursor); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := iterator.Err(); err != nil {
+			watcher.client.logger.Warn("failed to poll mirror node transactions", "watch", key, "error", err.Error())
+		}
+
+		if err := _MirrorPollSleep(ctx, watcher.pollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// _AccountWatchEvents returns the changes the transaction made to the account, always in the same order
+func _AccountWatchEvents(account string, transaction MirrorTransaction) []WatchEvent {
+	events := make([]WatchEvent, 0)
+	base := _NewWatchEvent(transaction)
+
+	for _, transfer := range transaction.Transfers {
+		if transfer.Account != account || transfer.Amount == 0 {
+			continue
+		}
+
+		event := base
+		event.Type = WatchEventHbarReceived
+		if transfer.Amount < 0 {
+			event.Type = WatchEventHbarSent
+		}
+		event.AccountID, _ = AccountIDFromString(transfer.Account)
+		event.Hbar = HbarFromTinybar(transfer.Amount)
+		events = append(events, event)
+	}
+
+	for _, transfer := range transaction.TokenTransfers {
+		if transfer.Account == account {
+			events = append(events, _TokenTransferWatchEvent(base, transfer))
+		}
+	}
+
+	for _, transfer := range transaction.NftTransfers {
+		if transfer.SenderAccountID == account || transfer.ReceiverAccountID == account {
+			event := _NftTransferWatchEvent(base, transfer)
+			event.AccountID, _ = AccountIDFromString(account)
+			events = append(events, event)
+		}
+	}
+
+	if transaction.Name == "CRYPTOAPPROVEALLOWANCE" || transaction.Name == "CRYPTODELETEALLOWANCE" {
+		if payer, _, _ := strings.Cut(transaction.TransactionID, "-"); payer == account {
+			event := base
+			event.Type = WatchEventAllowanceChange
+			event.AccountID, _ = AccountIDFromString(account)
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// _TokenWatchEvents returns the transfers of the token made by the transaction, always in the same order
+func _TokenWatchEvents(token string, transaction MirrorTransaction) []WatchEvent {
+	events := make([]WatchEvent, 0)
+	base := _NewWatchEvent(transaction)
+
+	for _, transfer := range transaction.TokenTransfers {
+		if transfer.TokenID == token {
+			events = append(events, _TokenTransferWatchEvent(base, transfer))
+		}
+	}
+
+	for _, transfer := range transaction.NftTransfers {
+		if transfer.TokenID == token {
+			events = append(events, _NftTransferWatchEvent(base, transfer))
+		}
+	}
+
+	return events
+}
+
+func _NewWatchEvent(transaction MirrorTransaction) WatchEvent {
+	timestamp, _ := transaction.ConsensusTimestamp.Time()
+
+	return WatchEvent{
+		ConsensusTimestamp: timestamp,
+		TransactionID:      transaction.TransactionID,
+		Transaction:        transaction,
+	}
+}
+
+func _TokenTransferWatchEvent(base WatchEvent, transfer MirrorTokenTransfer) WatchEvent {
+	event := base
+	event.Type = WatchEventTokenTransfer
+	event.AccountID, _ = AccountIDFromString(transfer.Account)
+	event.Amount = transfer.Amount
+	if tokenID, err := TokenIDFromString(transfer.TokenID); err == nil {
+		event.TokenID = &tokenID
+	}
+
+	return event
+}
+
+func _NftTransferWatchEvent(base WatchEvent, transfer MirrorNftTransfer) WatchEvent {
+	event := base
+	event.Type = WatchEventNftTransfer
+	if tokenID, err := TokenIDFromString(transfer.TokenID); err == nil {
+		event.TokenID = &tokenID
+		event.NftID = &NftID{TokenID: tokenID, SerialNumber: transfer.SerialNumber}
+	}
+	if sender, err := AccountIDFromString(transfer.SenderAccountID); err == nil && transfer.SenderAccountID != "" {
+		event.SenderAccountID = &sender
+		event.AccountID = sender
+	}
+	if receiver, err := AccountIDFromString(transfer.ReceiverAccountID); err == nil && transfer.ReceiverAccountID != "" {
+		event.ReceiverAccountID = &receiver
+		event.AccountID = receiver
+	}
+
+	return event
+}
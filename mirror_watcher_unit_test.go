@@ -0,0 +1,237 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _MockMirrorTransactions struct {
+	transactions []MirrorTransaction
+	mu           sync.Mutex
+}
+
+func (mock *_MockMirrorTransactions) _Add(transaction MirrorTransaction) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.transactions = append(mock.transactions, transaction)
+}
+
+func (mock *_MockMirrorTransactions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	from, _ := MirrorTimestamp(strings.TrimPrefix(r.URL.Query().Get("timestamp"), "gte:")).Time()
+
+	page := make([]MirrorTransaction, 0)
+	for _, transaction := range mock.transactions {
+		timestamp, _ := transaction.ConsensusTimestamp.Time()
+		if timestamp.Before(from) {
+			continue
+		}
+		page = append(page, transaction)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"transactions": page, "links": map[string]interface{}{"next": nil}})
+}
+
+func _WatchUntil(t *testing.T, watch func(ctx context.Context, onEvent func(WatchEvent) error) error, count int) []WatchEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	events := make([]WatchEvent, 0)
+	err := watch(ctx, func(event WatchEvent) error {
+		events = append(events, event)
+		if len(events) == count {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	return events
+}
+
+func TestUnitMirrorWatcherWatchAccount(t *testing.T) {
+	t.Parallel()
+
+	mock := &_MockMirrorTransactions{}
+	mock._Add(MirrorTransaction{
+		ConsensusTimestamp: "1700000001.000000000",
+		Result:             "SUCCESS",
+		TransactionID:      "0.0.2-1700000000-000000000",
+		Transfers:          []MirrorTransfer{{Account: "0.0.2", Amount: -10}, {Account: "0.0.5", Amount: 10}},
+		TokenTransfers:     []MirrorTokenTransfer{{TokenID: "0.0.9", Account: "0.0.5", Amount: -3}, {TokenID: "0.0.9", Account: "0.0.2", Amount: 3}},
+	})
+	mock._Add(MirrorTransaction{
+		ConsensusTimestamp: "1700000002.000000000",
+		Result:             "INSUFFICIENT_PAYER_BALANCE",
+		TransactionID:      "0.0.5-1700000001-000000000",
+		Transfers:          []MirrorTransfer{{Account: "0.0.5", Amount: -1}},
+	})
+	mock._Add(MirrorTransaction{
+		ConsensusTimestamp: "1700000003.000000000",
+		Name:               "CRYPTOAPPROVEALLOWANCE",
+		Result:             "SUCCESS",
+		TransactionID:      "0.0.5-1700000002-000000000",
+		NftTransfers:       []MirrorNftTransfer{{ReceiverAccountID: "0.0.5", SenderAccountID: "0.0.2", SerialNumber: 4, TokenID: "0.0.10"}},
+	})
+
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	store := NewFileWatchCursorStore(filepath.Join(t.TempDir(), "cursors.json"))
+	watcher := NewMirrorWatcher(client).
+		SetCursorStore(store).
+		SetStartTime(time.Unix(1700000000, 0)).
+		SetPollInterval(time.Millisecond)
+
+	watch := func(ctx context.Context, onEvent func(WatchEvent) error) error {
+		return watcher.WatchAccount(ctx, AccountID{Account: 5}, onEvent)
+	}
+
+	events := _WatchUntil(t, watch, 4)
+	require.Len(t, events, 4)
+
+	assert.Equal(t, WatchEventHbarReceived, events[0].Type)
+	assert.Equal(t, HbarFromTinybar(10), events[0].Hbar)
+	assert.Equal(t, AccountID{Account: 5}, events[0].AccountID)
+
+	assert.Equal(t, WatchEventTokenTransfer, events[1].Type)
+	assert.Equal(t, int64(-3), events[1].Amount)
+	assert.Equal(t, TokenID{Token: 9}, *events[1].TokenID)
+
+	assert.Equal(t, WatchEventNftTransfer, events[2].Type)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 10}, SerialNumber: 4}, *events[2].NftID)
+	assert.Equal(t, AccountID{Account: 2}, *events[2].SenderAccountID)
+	assert.Equal(t, AccountID{Account: 5}, *events[2].ReceiverAccountID)
+
+	assert.Equal(t, WatchEventAllowanceChange, events[3].Type)
+	assert.True(t, events[3].ConsensusTimestamp.Equal(time.Unix(1700000003, 0)))
+
+	// A restarted watcher continues after the last handled event
+	mock._Add(MirrorTransaction{
+		ConsensusTimestamp: "1700000004.000000000",
+		Result:             "SUCCESS",
+		TransactionID:      "0.0.5-1700000003-000000000",
+		Transfers:          []MirrorTransfer{{Account: "0.0.5", Amount: -7}, {Account: "0.0.3", Amount: 7}},
+	})
+
+	events = _WatchUntil(t, watch, 1)
+	require.Len(t, events, 1)
+	assert.Equal(t, WatchEventHbarSent, events[0].Type)
+	assert.Equal(t, HbarFromTinybar(-7), events[0].Hbar)
+
+	cursor, err := store.Load("account:0.0.5")
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.True(t, cursor.ConsensusTimestamp.Equal(time.Unix(1700000004, 0)))
+	assert.Equal(t, 0, cursor.Event)
+}
+
+func TestUnitMirrorWatcherWatchToken(t *testing.T) {
+	t.Parallel()
+
+	mock := &_MockMirrorTransactions{}
+	mock._Add(MirrorTransaction{
+		ConsensusTimestamp: "1700000001.000000000",
+		Result:             "SUCCESS",
+		TransactionID:      "0.0.2-1700000000-000000000",
+		TokenTransfers:     []MirrorTokenTransfer{{TokenID: "0.0.8", Account: "0.0.5", Amount: -1}, {TokenID: "0.0.9", Account: "0.0.5", Amount: -3}, {TokenID: "0.0.9", Account: "0.0.2", Amount: 3}},
+	})
+
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	watcher := NewMirrorWatcher(client).
+		SetStartTime(time.Unix(1700000000, 0)).
+		SetPollInterval(time.Millisecond)
+
+	events := _WatchUntil(t, func(ctx context.Context, onEvent func(WatchEvent) error) error {
+		return watcher.WatchToken(ctx, TokenID{Token: 9}, onEvent)
+	}, 2)
+	require.Len(t, events, 2)
+	assert.Equal(t, AccountID{Account: 5}, events[0].AccountID)
+	assert.Equal(t, int64(-3), events[0].Amount)
+	assert.Equal(t, AccountID{Account: 2}, events[1].AccountID)
+	assert.Equal(t, int64(3), events[1].Amount)
+}
+
+func TestUnitMirrorWatcherWatchTokenOfAccount(t *testing.T) {
+	t.Parallel()
+
+	mock := &_MockMirrorTransactions{}
+	mock._Add(MirrorTransaction{
+		ConsensusTimestamp: "1700000001.000000000",
+		Result:             "SUCCESS",
+		TransactionID:      "0.0.2-1700000000-000000000",
+		TokenTransfers:     []MirrorTokenTransfer{{TokenID: "0.0.8", Account: "0.0.5", Amount: -1}, {TokenID: "0.0.9", Account: "0.0.5", Amount: -3}, {TokenID: "0.0.9", Account: "0.0.2", Amount: 3}},
+		NftTransfers:       []MirrorNftTransfer{{TokenID: "0.0.9", SenderAccountID: "0.0.5", ReceiverAccountID: "0.0.6", SerialNumber: 1}},
+	})
+
+	var accountParam string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountParam = r.URL.Query().Get("account.id")
+		mock.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	watcher := NewMirrorWatcher(client).
+		SetStartTime(time.Unix(1700000000, 0)).
+		SetPollInterval(time.Millisecond)
+
+	events := _WatchUntil(t, func(ctx context.Context, onEvent func(WatchEvent) error) error {
+		return watcher.WatchTokenOfAccount(ctx, TokenID{Token: 9}, AccountID{Account: 5}, onEvent)
+	}, 2)
+	assert.Equal(t, "0.0.5", accountParam)
+	require.Len(t, events, 2)
+	assert.Equal(t, WatchEventTokenTransfer, events[0].Type)
+	assert.Equal(t, int64(-3), events[0].Amount)
+	assert.Equal(t, WatchEventNftTransfer, events[1].Type)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 9}, SerialNumber: 1}, *events[1].NftID)
+}
@@ -769,6 +769,10 @@ func NewMockHandler(responses []interface{}) func(interface{}, context.Context,
 func NewMockStreamHandler(responses []interface{}) func(interface{}, grpc.ServerStream) error {
 	return func(_ interface{}, stream grpc.ServerStream) error {
 		for _, resp := range responses {
+			if err, ok := resp.(error); ok {
+				return err
+			}
+
 			err := stream.SendMsg(resp)
 			if err != nil {
 				return err
@@ -801,6 +805,7 @@ func NewMockServer(responses []interface{}) (server *MockServer) {
 	server.server.RegisterService(NewServiceDescription(handler, &services.FreezeService_ServiceDesc), nil)
 	server.server.RegisterService(NewServiceDescription(handler, &services.NetworkService_ServiceDesc), nil)
 	server.server.RegisterService(NewMirrorServiceDescription(streamHandler, &mirror.NetworkService_ServiceDesc), nil)
+	server.server.RegisterService(NewMirrorServiceDescription(streamHandler, &mirror.ConsensusService_ServiceDesc), nil)
 
 	server.listener, err = net.Listen("tcp", "localhost:0")
 	if err != nil {
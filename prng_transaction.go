@@ -187,6 +187,18 @@ func (tx *PrngTransaction) Execute(client *Client) (TransactionResponse, error)
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the PrngTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *PrngTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed PrngTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *PrngTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *PrngTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -301,6 +301,18 @@ func (tx *ScheduleCreateTransaction) Execute(client *Client) (TransactionRespons
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the ScheduleCreateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *ScheduleCreateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed ScheduleCreateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *ScheduleCreateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *ScheduleCreateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -202,6 +202,18 @@ func (tx *ScheduleSignTransaction) Execute(client *Client) (TransactionResponse,
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the ScheduleSignTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *ScheduleSignTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed ScheduleSignTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *ScheduleSignTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *ScheduleSignTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
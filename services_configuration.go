@@ -0,0 +1,147 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ServicesConfigurationSetting is a single named setting of a ServicesConfiguration
+type ServicesConfigurationSetting struct {
+	Name  string
+	Value string
+	Data  []byte
+}
+
+// ServicesConfiguration is a list of named settings, the format of the application properties (0.0.121)
+// and API permissions (0.0.122) system files
+type ServicesConfiguration struct {
+	Settings []ServicesConfigurationSetting
+}
+
+func _ServicesConfigurationFromProtobuf(pb *services.ServicesConfigurationList) ServicesConfiguration {
+	settings := make([]ServicesConfigurationSetting, 0)
+	if pb == nil {
+		return ServicesConfiguration{Settings: settings}
+	}
+
+	for _, setting := range pb.GetNameValue() {
+		settings = append(settings, ServicesConfigurationSetting{
+			Name:  setting.GetName(),
+			Value: setting.GetValue(),
+			Data:  setting.GetData(),
+		})
+	}
+
+	return ServicesConfiguration{
+		Settings: settings,
+	}
+}
+
+func (configuration ServicesConfiguration) _ToProtobuf() *services.ServicesConfigurationList {
+	settings := make([]*services.Setting, 0)
+	for _, setting := range configuration.Settings {
+		settings = append(settings, &services.Setting{
+			Name:  setting.Name,
+			Value: setting.Value,
+			Data:  setting.Data,
+		})
+	}
+
+	return &services.ServicesConfigurationList{
+		NameValue: settings,
+	}
+}
+
+// Get returns the value of the named setting and whether it is present
+func (configuration ServicesConfiguration) Get(name string) (string, bool) {
+	for _, setting := range configuration.Settings {
+		if setting.Name == name {
+			return setting.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Set replaces the value of the named setting, adding it if it isn't present
+func (configuration *ServicesConfiguration) Set(name string, value string) *ServicesConfiguration {
+	for i, setting := range configuration.Settings {
+		if setting.Name == name {
+			configuration.Settings[i].Value = value
+			return configuration
+		}
+	}
+
+	configuration.Settings = append(configuration.Settings, ServicesConfigurationSetting{
+		Name:  name,
+		Value: value,
+	})
+
+	return configuration
+}
+
+// ToMap returns the settings as a map of name to value
+func (configuration ServicesConfiguration) ToMap() map[string]string {
+	result := make(map[string]string)
+	for _, setting := range configuration.Settings {
+		result[setting.Name] = setting.Value
+	}
+
+	return result
+}
+
+// ToBytes returns the byte representation of the ServicesConfiguration
+func (configuration ServicesConfiguration) ToBytes() []byte {
+	data, err := protobuf.Marshal(configuration._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// ServicesConfigurationFromBytes returns a ServicesConfiguration from the contents of a configuration system file
+func ServicesConfigurationFromBytes(data []byte) (ServicesConfiguration, error) {
+	if data == nil {
+		return ServicesConfiguration{}, errByteArrayNull
+	}
+	pb := services.ServicesConfigurationList{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return ServicesConfiguration{}, err
+	}
+
+	return _ServicesConfigurationFromProtobuf(&pb), nil
+}
+
+// String returns a string representation of the ServicesConfiguration
+func (configuration ServicesConfiguration) String() string {
+	str := ""
+	for _, setting := range configuration.Settings {
+		str += fmt.Sprintf("%s=%s\n", setting.Name, setting.Value)
+	}
+
+	return str
+}
@@ -0,0 +1,108 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// The system contracts of the Hedera network, which contracts call like any other contract at their fixed address
+var (
+	// HederaTokenServiceContractID is the system contract of the Hedera Token Service, IHederaTokenService
+	HederaTokenServiceContractID = ContractID{Contract: 0x167}
+	// ExchangeRateContractID is the system contract converting between tinycents and tinybars
+	ExchangeRateContractID = ContractID{Contract: 0x168}
+	// PrngContractID is the system contract generating pseudorandom seeds
+	PrngContractID = ContractID{Contract: 0x169}
+)
+
+const _ExchangeRateABI = `[
+	{"type":"function","name":"tinycentsToTinybars","stateMutability":"payable",
+		"inputs":[{"name":"tinycents","type":"uint256"}],"outputs":[{"name":"tinybars","type":"uint256"}]},
+	{"type":"function","name":"tinybarsToTinycents","stateMutability":"payable",
+		"inputs":[{"name":"tinybars","type":"uint256"}],"outputs":[{"name":"tinycents","type":"uint256"}]}
+]`
+
+const _PrngABI = `[
+	{"type":"function","name":"getPseudorandomSeed","stateMutability":"nonpayable",
+		"inputs":[],"outputs":[{"name":"","type":"bytes32"}]}
+]`
+
+var (
+	_ExchangeRateContractABI = _MustContractABI(_ExchangeRateABI)
+	_PrngContractABI         = _MustContractABI(_PrngABI)
+)
+
+// _MustContractABI parses the ABI of a system contract, which is known to be valid
+func _MustContractABI(data string) *ContractABI {
+	contractABI, err := ContractABIFromJSON([]byte(data))
+	if err != nil {
+		panic(err)
+	}
+
+	return contractABI
+}
+
+// ExchangeRateTinycentsToTinybars encodes the call of the exchange rate system contract converting tinycents
+// to tinybars at the current exchange rate
+func ExchangeRateTinycentsToTinybars(tinycents uint64) ([]byte, error) {
+	return _ExchangeRateContractABI.Pack("tinycentsToTinybars", tinycents)
+}
+
+// ExchangeRateTinybarsToTinycents encodes the call of the exchange rate system contract converting tinybars
+// to tinycents at the current exchange rate
+func ExchangeRateTinybarsToTinycents(tinybars uint64) ([]byte, error) {
+	return _ExchangeRateContractABI.Pack("tinybarsToTinycents", tinybars)
+}
+
+// ExchangeRateDecodeResult decodes the amount the exchange rate system contract converted to, returned by both
+// of its functions
+func ExchangeRateDecodeResult(output []byte) (uint64, error) {
+	values, err := _ExchangeRateContractABI.Unpack("tinycentsToTinybars", output)
+	if err != nil {
+		return 0, err
+	}
+
+	amount := values[0].(*big.Int)
+	if !amount.IsUint64() {
+		return 0, errors.Errorf("converted amount %s overflows uint64", amount.String())
+	}
+
+	return amount.Uint64(), nil
+}
+
+// PrngGetPseudorandomSeed encodes the call of the PRNG system contract returning a 32 byte pseudorandom seed
+func PrngGetPseudorandomSeed() ([]byte, error) {
+	return _PrngContractABI.Pack("getPseudorandomSeed")
+}
+
+// PrngDecodePseudorandomSeed decodes the 32 byte seed returned by the PRNG system contract
+func PrngDecodePseudorandomSeed(output []byte) ([]byte, error) {
+	values, err := _PrngContractABI.Unpack("getPseudorandomSeed", output)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := values[0].([32]byte)
+	return seed[:], nil
+}
@@ -0,0 +1,74 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitSystemContractIDs(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "0000000000000000000000000000000000000167", HederaTokenServiceContractID.ToSolidityAddress())
+	require.Equal(t, "0000000000000000000000000000000000000168", ExchangeRateContractID.ToSolidityAddress())
+	require.Equal(t, "0000000000000000000000000000000000000169", PrngContractID.ToSolidityAddress())
+	require.Equal(t, "00000000000000000000000000000000000004d2", HRCContractID(TokenID{Token: 1234}).ToSolidityAddress())
+}
+
+func TestUnitExchangeRateSystemContract(t *testing.T) {
+	t.Parallel()
+
+	data, err := ExchangeRateTinycentsToTinybars(100)
+	require.NoError(t, err)
+	require.Equal(t, "2e3cff6a"+"0000000000000000000000000000000000000000000000000000000000000064", hex.EncodeToString(data))
+
+	data, err = ExchangeRateTinybarsToTinycents(100)
+	require.NoError(t, err)
+	require.Equal(t, "43a88229", hex.EncodeToString(data[:4]))
+
+	amount, err := ExchangeRateDecodeResult(_TestPackOutputs(t, _ExchangeRateContractABI, "tinycentsToTinybars", uint64(8333)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(8333), amount)
+
+	_, err = ExchangeRateDecodeResult(_TestPackOutputs(t, _ExchangeRateContractABI, "tinycentsToTinybars", new(big.Int).Lsh(big.NewInt(1), 70)))
+	require.Error(t, err)
+}
+
+func TestUnitPrngSystemContract(t *testing.T) {
+	t.Parallel()
+
+	data, err := PrngGetPseudorandomSeed()
+	require.NoError(t, err)
+	require.Equal(t, "d83bf9a1", hex.EncodeToString(data))
+
+	seed := make([]byte, 32)
+	seed[0], seed[31] = 0xab, 0xcd
+	decoded, err := PrngDecodePseudorandomSeed(_TestPackOutputs(t, _PrngContractABI, "getPseudorandomSeed", seed))
+	require.NoError(t, err)
+	require.Equal(t, seed, decoded)
+}
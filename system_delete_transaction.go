@@ -234,6 +234,18 @@ func (tx *SystemDeleteTransaction) Execute(client *Client) (TransactionResponse,
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the SystemDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *SystemDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed SystemDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *SystemDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *SystemDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
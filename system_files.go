@@ -0,0 +1,168 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import "time"
+
+// systemFileUpdateChunkSize is how many bytes of a system file are carried by the FileUpdateTransaction
+// returned from NewSystemFileUpdate, the remainder being left to a FileAppendTransaction
+const systemFileUpdateChunkSize = 4096
+
+// SystemFiles reads and decodes the network's system files using FileContentsQuery.
+type SystemFiles struct {
+	client          *Client
+	nodeAccountIDs  []AccountID
+	maxQueryPayment Hbar
+	grpcDeadline    *time.Duration
+}
+
+// NewSystemFiles creates a SystemFiles which queries the network of the given client
+func NewSystemFiles(client *Client) *SystemFiles {
+	return &SystemFiles{
+		client: client,
+	}
+}
+
+// SetNodeAccountIDs sets the nodes the system files are queried from
+func (files *SystemFiles) SetNodeAccountIDs(nodeAccountIDs []AccountID) *SystemFiles {
+	files.nodeAccountIDs = nodeAccountIDs
+	return files
+}
+
+// SetMaxQueryPayment sets the maximum payment for each FileContentsQuery
+func (files *SystemFiles) SetMaxQueryPayment(maxPayment Hbar) *SystemFiles {
+	files.maxQueryPayment = maxPayment
+	return files
+}
+
+// SetGrpcDeadline sets the grpc deadline of each FileContentsQuery
+func (files *SystemFiles) SetGrpcDeadline(deadline *time.Duration) *SystemFiles {
+	files.grpcDeadline = deadline
+	return files
+}
+
+// GetContents returns the raw contents of the given file
+func (files *SystemFiles) GetContents(fileID FileID) ([]byte, error) {
+	query := NewFileContentsQuery().
+		SetFileID(fileID)
+	if len(files.nodeAccountIDs) > 0 {
+		query.SetNodeAccountIDs(files.nodeAccountIDs)
+	}
+	if files.maxQueryPayment.AsTinybar() > 0 {
+		query.SetMaxQueryPayment(files.maxQueryPayment)
+	}
+	if files.grpcDeadline != nil {
+		query.SetGrpcDeadline(files.grpcDeadline)
+	}
+
+	return query.Execute(files.client)
+}
+
+// GetNodeAddressBook returns the nodes' own address book from file 0.0.101
+func (files *SystemFiles) GetNodeAddressBook() (NodeAddressBook, error) {
+	contents, err := files.GetContents(FileIDForNodeAddressBook())
+	if err != nil {
+		return NodeAddressBook{}, err
+	}
+
+	return NodeAddressBookFromBytes(contents)
+}
+
+// GetAddressBook returns the node details address book from file 0.0.102
+func (files *SystemFiles) GetAddressBook() (NodeAddressBook, error) {
+	contents, err := files.GetContents(FileIDForAddressBook())
+	if err != nil {
+		return NodeAddressBook{}, err
+	}
+
+	return NodeAddressBookFromBytes(contents)
+}
+
+// GetFeeSchedules returns the current and next fee schedules from file 0.0.111
+func (files *SystemFiles) GetFeeSchedules() (FeeSchedules, error) {
+	contents, err := files.GetContents(FileIDForFeeSchedule())
+	if err != nil {
+		return FeeSchedules{}, err
+	}
+
+	return FeeSchedulesFromBytes(contents)
+}
+
+// GetExchangeRates returns the current and next exchange rates from file 0.0.112
+func (files *SystemFiles) GetExchangeRates() (ExchangeRates, error) {
+	contents, err := files.GetContents(FileIDForExchangeRate())
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	return ExchangeRatesFromBytes(contents)
+}
+
+// GetApplicationProperties returns the application properties from file 0.0.121
+func (files *SystemFiles) GetApplicationProperties() (ServicesConfiguration, error) {
+	contents, err := files.GetContents(FileIDForApplicationProperties())
+	if err != nil {
+		return ServicesConfiguration{}, err
+	}
+
+	return ServicesConfigurationFromBytes(contents)
+}
+
+// GetApiPermissions returns the API permissions from file 0.0.122
+func (files *SystemFiles) GetApiPermissions() (ServicesConfiguration, error) {
+	contents, err := files.GetContents(FileIDForApiPermissions())
+	if err != nil {
+		return ServicesConfiguration{}, err
+	}
+
+	return ServicesConfigurationFromBytes(contents)
+}
+
+// GetThrottleDefinitions returns the throttle definitions from file 0.0.123
+func (files *SystemFiles) GetThrottleDefinitions() (ThrottleDefinitions, error) {
+	contents, err := files.GetContents(FileIDForThrottleDefinitions())
+	if err != nil {
+		return ThrottleDefinitions{}, err
+	}
+
+	return ThrottleDefinitionsFromBytes(contents)
+}
+
+// NewSystemFileUpdate prepares the transactions replacing the contents of a system file, typically
+// produced by ToBytes of the matching decoded type. The FileUpdateTransaction carries the first
+// bytes of contents; when the contents don't fit in it, the returned FileAppendTransaction carries
+// the rest and must be executed after the update. Otherwise the FileAppendTransaction is nil.
+func NewSystemFileUpdate(fileID FileID, contents []byte) (*FileUpdateTransaction, *FileAppendTransaction) {
+	if len(contents) <= systemFileUpdateChunkSize {
+		return NewFileUpdateTransaction().
+			SetFileID(fileID).
+			SetContents(contents), nil
+	}
+
+	update := NewFileUpdateTransaction().
+		SetFileID(fileID).
+		SetContents(contents[:systemFileUpdateChunkSize])
+	appendTx := NewFileAppendTransaction().
+		SetFileID(fileID).
+		SetContents(contents[systemFileUpdateChunkSize:])
+
+	return update, appendTx
+}
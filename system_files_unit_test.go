@@ -0,0 +1,156 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockSystemFileResponses(contents []byte) []interface{} {
+	cost := &services.Response{
+		Response: &services.Response_FileGetContents{
+			FileGetContents: &services.FileGetContentsResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 3},
+			},
+		},
+	}
+
+	return []interface{}{
+		cost,
+		&services.Response{
+			Response: &services.Response_FileGetContents{
+				FileGetContents: &services.FileGetContentsResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY, Cost: 2},
+					FileContents: &services.FileGetContentsResponse_FileContents{
+						FileID:   &services.FileID{FileNum: 112},
+						Contents: contents,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUnitExchangeRatesFromBytes(t *testing.T) {
+	t.Parallel()
+
+	expiration := time.Unix(1700000000, 0)
+	rates := ExchangeRates{
+		Current: NewExchangeRate(30000, 150000, expiration),
+		Next:    NewExchangeRate(30000, 160000, expiration.Add(time.Hour)),
+	}
+
+	decoded, err := ExchangeRatesFromBytes(rates.ToBytes())
+	require.NoError(t, err)
+	require.Equal(t, int32(30000), decoded.Current.Hbars)
+	require.Equal(t, int32(150000), decoded.Current.GetCents())
+	require.Equal(t, expiration, decoded.Current.GetExpirationTime())
+	require.Equal(t, int32(160000), decoded.Next.GetCents())
+	require.Equal(t, expiration.Add(time.Hour), decoded.Next.GetExpirationTime())
+
+	_, err = ExchangeRatesFromBytes(nil)
+	require.Error(t, err)
+}
+
+func TestUnitServicesConfigurationFromBytes(t *testing.T) {
+	t.Parallel()
+
+	configuration := ServicesConfiguration{}
+	configuration.
+		Set("ledger.transfers.maxLen", "10").
+		Set("contracts.maxGasPerSec", "15000000").
+		Set("ledger.transfers.maxLen", "20")
+
+	decoded, err := ServicesConfigurationFromBytes(configuration.ToBytes())
+	require.NoError(t, err)
+	require.Len(t, decoded.Settings, 2)
+
+	value, ok := decoded.Get("ledger.transfers.maxLen")
+	require.True(t, ok)
+	require.Equal(t, "20", value)
+
+	_, ok = decoded.Get("missing")
+	require.False(t, ok)
+	require.Equal(t, "15000000", decoded.ToMap()["contracts.maxGasPerSec"])
+}
+
+func TestUnitThrottleDefinitionsFromBytes(t *testing.T) {
+	t.Parallel()
+
+	definitions := ThrottleDefinitions{
+		Buckets: []ThrottleBucket{{
+			Name:          "ThroughputLimits",
+			BurstPeriodMs: 1000,
+			Groups: []ThrottleGroup{{
+				Operations:     []RequestType{RequestTypeCryptoTransfer, RequestTypeCryptoCreate},
+				MilliOpsPerSec: 10000000,
+			}},
+		}},
+	}
+
+	decoded, err := ThrottleDefinitionsFromBytes(definitions.ToBytes())
+	require.NoError(t, err)
+	require.Equal(t, definitions, decoded)
+	require.Contains(t, decoded.String(), "CryptoTransfer")
+}
+
+func TestUnitSystemFilesGetExchangeRates(t *testing.T) {
+	t.Parallel()
+
+	expiration := time.Unix(1700000000, 0)
+	rates := ExchangeRates{
+		Current: NewExchangeRate(1, 12, expiration),
+		Next:    NewExchangeRate(1, 13, expiration),
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{_MockSystemFileResponses(rates.ToBytes())})
+	defer server.Close()
+
+	decoded, err := NewSystemFiles(client).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetMaxQueryPayment(NewHbar(1)).
+		GetExchangeRates()
+	require.NoError(t, err)
+	require.Equal(t, int32(12), decoded.Current.GetCents())
+	require.Equal(t, int32(13), decoded.Next.GetCents())
+}
+
+func TestUnitNewSystemFileUpdate(t *testing.T) {
+	t.Parallel()
+
+	update, appendTx := NewSystemFileUpdate(FileIDForThrottleDefinitions(), []byte{1, 2, 3})
+	require.Equal(t, []byte{1, 2, 3}, update.GetContents())
+	require.Nil(t, appendTx)
+
+	contents := make([]byte, systemFileUpdateChunkSize+10)
+	update, appendTx = NewSystemFileUpdate(FileIDForApplicationProperties(), contents)
+	require.Len(t, update.GetContents(), systemFileUpdateChunkSize)
+	require.NotNil(t, appendTx)
+	require.Len(t, appendTx.GetContents(), 10)
+	require.Equal(t, FileIDForApplicationProperties(), appendTx.GetFileID())
+}
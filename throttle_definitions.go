@@ -0,0 +1,145 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ThrottleGroup is a set of operations sharing a throughput limit within a ThrottleBucket
+type ThrottleGroup struct {
+	Operations []RequestType
+	// The throughput allowed for the operations, in thousandths of an operation per second
+	MilliOpsPerSec uint64
+}
+
+// ThrottleBucket is a named throttle with a burst period shared by its groups
+type ThrottleBucket struct {
+	Name          string
+	BurstPeriodMs uint64
+	Groups        []ThrottleGroup
+}
+
+// ThrottleDefinitions are the network's throttles, as stored in the throttle definitions system file 0.0.123
+type ThrottleDefinitions struct {
+	Buckets []ThrottleBucket
+}
+
+func _ThrottleDefinitionsFromProtobuf(pb *services.ThrottleDefinitions) ThrottleDefinitions {
+	buckets := make([]ThrottleBucket, 0)
+	if pb == nil {
+		return ThrottleDefinitions{Buckets: buckets}
+	}
+
+	for _, bucket := range pb.GetThrottleBuckets() {
+		groups := make([]ThrottleGroup, 0)
+		for _, group := range bucket.GetThrottleGroups() {
+			operations := make([]RequestType, 0)
+			for _, operation := range group.GetOperations() {
+				operations = append(operations, RequestType(operation))
+			}
+			groups = append(groups, ThrottleGroup{
+				Operations:     operations,
+				MilliOpsPerSec: group.GetMilliOpsPerSec(),
+			})
+		}
+		buckets = append(buckets, ThrottleBucket{
+			Name:          bucket.GetName(),
+			BurstPeriodMs: bucket.GetBurstPeriodMs(),
+			Groups:        groups,
+		})
+	}
+
+	return ThrottleDefinitions{
+		Buckets: buckets,
+	}
+}
+
+func (definitions ThrottleDefinitions) _ToProtobuf() *services.ThrottleDefinitions {
+	buckets := make([]*services.ThrottleBucket, 0)
+	for _, bucket := range definitions.Buckets {
+		groups := make([]*services.ThrottleGroup, 0)
+		for _, group := range bucket.Groups {
+			operations := make([]services.HederaFunctionality, 0)
+			for _, operation := range group.Operations {
+				operations = append(operations, services.HederaFunctionality(operation))
+			}
+			groups = append(groups, &services.ThrottleGroup{
+				Operations:     operations,
+				MilliOpsPerSec: group.MilliOpsPerSec,
+			})
+		}
+		buckets = append(buckets, &services.ThrottleBucket{
+			Name:           bucket.Name,
+			BurstPeriodMs:  bucket.BurstPeriodMs,
+			ThrottleGroups: groups,
+		})
+	}
+
+	return &services.ThrottleDefinitions{
+		ThrottleBuckets: buckets,
+	}
+}
+
+// ToBytes returns the byte representation of the ThrottleDefinitions
+func (definitions ThrottleDefinitions) ToBytes() []byte {
+	data, err := protobuf.Marshal(definitions._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// ThrottleDefinitionsFromBytes returns ThrottleDefinitions from the contents of the throttle definitions system file
+func ThrottleDefinitionsFromBytes(data []byte) (ThrottleDefinitions, error) {
+	if data == nil {
+		return ThrottleDefinitions{}, errByteArrayNull
+	}
+	pb := services.ThrottleDefinitions{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return ThrottleDefinitions{}, err
+	}
+
+	return _ThrottleDefinitionsFromProtobuf(&pb), nil
+}
+
+// String returns a string representation of the ThrottleDefinitions
+func (definitions ThrottleDefinitions) String() string {
+	str := ""
+	for _, bucket := range definitions.Buckets {
+		str += fmt.Sprintf("%s (burst %dms)\n", bucket.Name, bucket.BurstPeriodMs)
+		for _, group := range bucket.Groups {
+			operations := make([]string, 0)
+			for _, operation := range group.Operations {
+				operations = append(operations, services.HederaFunctionality(operation).String())
+			}
+			str += fmt.Sprintf("  %d mops: %s\n", group.MilliOpsPerSec, strings.Join(operations, ", "))
+		}
+	}
+
+	return str
+}
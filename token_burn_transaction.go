@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -248,6 +249,18 @@ func (tx *TokenBurnTransaction) Execute(client *Client) (TransactionResponse, er
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenBurnTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenBurnTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenBurnTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenBurnTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenBurnTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -272,6 +285,26 @@ func (tx *TokenBurnTransaction) validateNetworkOnIDs(client *Client) error {
 	return nil
 }
 
+func (tx *TokenBurnTransaction) validateLocal() []ValidationFinding {
+	findings := make([]ValidationFinding, 0)
+
+	if len(tx.serial) > maxNftBatchSize {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusBatchSizeLimitExceeded,
+			Message: fmt.Sprintf("%d serial numbers, the maximum is %d", len(tx.serial), maxNftBatchSize),
+		})
+	}
+
+	if tx.amount != 0 && len(tx.serial) != 0 {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusInvalidTransactionBody,
+			Message: "both an amount and serial numbers are set, only one may be used",
+		})
+	}
+
+	return findings
+}
+
 func (tx *TokenBurnTransaction) build() *services.TransactionBody {
 	return &services.TransactionBody{
 		TransactionFee:           tx.transactionFee,
@@ -608,6 +608,18 @@ func (tx *TokenCreateTransaction) Execute(client *Client) (TransactionResponse,
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenCreateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenCreateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenCreateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenCreateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenCreateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
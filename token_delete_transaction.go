@@ -197,6 +197,18 @@ func (tx *TokenDeleteTransaction) Execute(client *Client) (TransactionResponse,
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenDeleteTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenDeleteTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenDeleteTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenDeleteTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenDeleteTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
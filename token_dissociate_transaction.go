@@ -252,6 +252,18 @@ func (tx *TokenDissociateTransaction) Execute(client *Client) (TransactionRespon
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenDissociateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenDissociateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenDissociateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenDissociateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenDissociateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -225,6 +225,18 @@ func (tx *TokenFeeScheduleUpdateTransaction) Execute(client *Client) (Transactio
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenFeeScheduleUpdateTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenFeeScheduleUpdateTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenFeeScheduleUpdateTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenFeeScheduleUpdateTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenFeeScheduleUpdateTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -224,6 +224,18 @@ func (tx *TokenGrantKycTransaction) Execute(client *Client) (TransactionResponse
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenGrantKycTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenGrantKycTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenGrantKycTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenGrantKycTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenGrantKycTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
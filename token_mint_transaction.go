@@ -21,11 +21,18 @@ package hedera
  */
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 )
 
+// Network limits on the NFTs a single mint or burn may touch
+const (
+	maxNftBatchSize     = 10
+	maxNftMetadataBytes = 100
+)
+
 // TokenMintTransaction
 // Mints tokens from the Token's treasury Account. If no Supply Key is defined, the transaction
 // will resolve to TOKEN_HAS_NO_SUPPLY_KEY.
@@ -244,6 +251,18 @@ func (tx *TokenMintTransaction) Execute(client *Client) (TransactionResponse, er
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenMintTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenMintTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenMintTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenMintTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenMintTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -268,6 +287,35 @@ func (tx *TokenMintTransaction) validateNetworkOnIDs(client *Client) error {
 	return nil
 }
 
+func (tx *TokenMintTransaction) validateLocal() []ValidationFinding {
+	findings := make([]ValidationFinding, 0)
+
+	if len(tx.meta) > maxNftBatchSize {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusBatchSizeLimitExceeded,
+			Message: fmt.Sprintf("%d metadata entries, the maximum is %d", len(tx.meta), maxNftBatchSize),
+		})
+	}
+
+	for i, meta := range tx.meta {
+		if len(meta) > maxNftMetadataBytes {
+			findings = append(findings, ValidationFinding{
+				Status:  StatusMetadataTooLong,
+				Message: fmt.Sprintf("metadata entry %d is %d bytes, the maximum is %d", i, len(meta), maxNftMetadataBytes),
+			})
+		}
+	}
+
+	if tx.amount != 0 && len(tx.meta) != 0 {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusInvalidTransactionBody,
+			Message: "both an amount and metadata are set, only one may be used",
+		})
+	}
+
+	return findings
+}
+
 func (tx *TokenMintTransaction) build() *services.TransactionBody {
 	return &services.TransactionBody{
 		TransactionFee:           tx.transactionFee,
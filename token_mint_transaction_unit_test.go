@@ -290,3 +290,32 @@ func TestUnitTokenMintTransactionMock(t *testing.T) {
 	_, err = freez.Sign(newKey).Execute(client)
 	require.NoError(t, err)
 }
+
+func TestUnitTokenMintTransactionValidateLocal(t *testing.T) {
+	t.Parallel()
+
+	err := NewTokenMintTransaction().
+		SetTokenID(TokenID{Token: 3}).
+		SetMetadata(make([]byte, 100)).
+		Validate()
+	require.NoError(t, err)
+
+	metadata := make([][]byte, 11)
+	for i := range metadata {
+		metadata[i] = []byte{byte(i)}
+	}
+	metadata[0] = make([]byte, 101)
+
+	err = NewTokenMintTransaction().
+		SetTokenID(TokenID{Token: 3}).
+		SetMetadatas(metadata).
+		SetAmount(5).
+		Validate()
+
+	var validationErr ErrLocalValidation
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Findings, 3)
+	require.Equal(t, StatusBatchSizeLimitExceeded, validationErr.Findings[0].Status)
+	require.Equal(t, StatusMetadataTooLong, validationErr.Findings[1].Status)
+	require.Equal(t, StatusInvalidTransactionBody, validationErr.Findings[2].Status)
+}
@@ -201,6 +201,18 @@ func (tx *TokenPauseTransaction) Execute(client *Client) (TransactionResponse, e
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenPauseTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenPauseTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenPauseTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenPauseTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenPauseTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
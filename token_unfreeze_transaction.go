@@ -226,6 +226,18 @@ func (tx *TokenUnfreezeTransaction) Execute(client *Client) (TransactionResponse
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenUnfreezeTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenUnfreezeTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenUnfreezeTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenUnfreezeTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenUnfreezeTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
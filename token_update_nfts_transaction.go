@@ -185,6 +185,18 @@ func (tx *TokenUpdateNfts) Execute(client *Client) (TransactionResponse, error)
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenUpdateNfts and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenUpdateNfts) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenUpdateNfts sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenUpdateNfts) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenUpdateNfts) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -269,6 +269,18 @@ func (tx *TokenWipeTransaction) Execute(client *Client) (TransactionResponse, er
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TokenWipeTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TokenWipeTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TokenWipeTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TokenWipeTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TokenWipeTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -0,0 +1,210 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sync"
+	"time"
+)
+
+// TopicCheckpoint is the position of a topic subscription: the last message delivered to it
+type TopicCheckpoint struct {
+	TopicID            TopicID
+	ConsensusTimestamp time.Time
+	SequenceNumber     uint64
+}
+
+// CheckpointStore persists the position of topic subscriptions, letting TopicMessageQuery resume right
+// after the last delivered message, including across process restarts.
+type CheckpointStore interface {
+	// Load returns the checkpoint of the topic, or nil if there is none
+	Load(topicID TopicID) (*TopicCheckpoint, error)
+	// Save records the checkpoint of its topic, replacing the previous one
+	Save(checkpoint TopicCheckpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore kept in memory, resuming subscriptions within a process.
+type MemoryCheckpointStore struct {
+	checkpoints map[TopicID]TopicCheckpoint
+	mu          sync.Mutex
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		checkpoints: make(map[TopicID]TopicCheckpoint),
+	}
+}
+
+// Load returns the checkpoint of the topic, or nil if there is none
+func (store *MemoryCheckpointStore) Load(topicID TopicID) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoint, ok := store.checkpoints[topicID._WithoutChecksum()]
+	if !ok {
+		return nil, nil
+	}
+
+	return &checkpoint, nil
+}
+
+// Save records the checkpoint of its topic, replacing the previous one
+func (store *MemoryCheckpointStore) Save(checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.checkpoints[checkpoint.TopicID._WithoutChecksum()] = checkpoint
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore persisting the checkpoints of every topic as JSON in a single file.
+// The file is replaced through a synced temporary file, so a crash never leaves it half written. By default
+// every save writes the file; SetSaveInterval batches the writes.
+type FileCheckpointStore struct {
+	path         string
+	saveInterval time.Duration
+	checkpoints  map[string]_FileCheckpoint
+	lastWrite    time.Time
+	dirty        bool
+	mu           sync.Mutex
+}
+
+type _FileCheckpoint struct {
+	Seconds        int64  `json:"seconds"`
+	Nanos          int64  `json:"nanos"`
+	SequenceNumber uint64 `json:"sequenceNumber"`
+}
+
+// _CheckpointFlusher is implemented by stores which hold back saves, flushed once a subscription stops
+type _CheckpointFlusher interface {
+	Flush() error
+}
+
+// NewFileCheckpointStore creates a FileCheckpointStore backed by the file at path, which is created on the first save
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		path: path,
+	}
+}
+
+// SetSaveInterval sets the minimum time between writes of the file. Saves made sooner after the previous
+// write are only kept in memory until a later save or Flush writes them, so after a crash a subscription
+// may resume up to interval early and receive some messages again. Subscriptions flush the store when
+// they stop.
+func (store *FileCheckpointStore) SetSaveInterval(interval time.Duration) *FileCheckpointStore {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.saveInterval = interval
+	return store
+}
+
+// GetSaveInterval returns the minimum time between writes of the file
+func (store *FileCheckpointStore) GetSaveInterval() time.Duration {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.saveInterval
+}
+
+// Load returns the checkpoint of the topic, or nil if there is none
+func (store *FileCheckpointStore) Load(topicID TopicID) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoints, err := store._Read()
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, ok := checkpoints[topicID._WithoutChecksum().String()]
+	if !ok {
+		return nil, nil
+	}
+
+	return &TopicCheckpoint{
+		TopicID:            topicID._WithoutChecksum(),
+		ConsensusTimestamp: time.Unix(checkpoint.Seconds, checkpoint.Nanos),
+		SequenceNumber:     checkpoint.SequenceNumber,
+	}, nil
+}
+
+// Save records the checkpoint of its topic, replacing the previous one
+func (store *FileCheckpointStore) Save(checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoints, err := store._Read()
+	if err != nil {
+		return err
+	}
+
+	checkpoints[checkpoint.TopicID._WithoutChecksum().String()] = _FileCheckpoint{
+		Seconds:        checkpoint.ConsensusTimestamp.Unix(),
+		Nanos:          int64(checkpoint.ConsensusTimestamp.Nanosecond()),
+		SequenceNumber: checkpoint.SequenceNumber,
+	}
+	store.dirty = true
+
+	if time.Since(store.lastWrite) < store.saveInterval {
+		return nil
+	}
+
+	return store._Write()
+}
+
+// Flush writes the checkpoints held back by the save interval
+func (store *FileCheckpointStore) Flush() error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if !store.dirty {
+		return nil
+	}
+
+	return store._Write()
+}
+
+// _Read returns the checkpoints, reading the file the first time
+func (store *FileCheckpointStore) _Read() (map[string]_FileCheckpoint, error) {
+	if store.checkpoints != nil {
+		return store.checkpoints, nil
+	}
+
+	checkpoints := make(map[string]_FileCheckpoint)
+	if err := _ReadJSONFile(store.path, &checkpoints); err != nil {
+		return nil, err
+	}
+	store.checkpoints = checkpoints
+
+	return checkpoints, nil
+}
+
+func (store *FileCheckpointStore) _Write() error {
+	if err := _WriteJSONFile(store.path, store.checkpoints); err != nil {
+		return err
+	}
+
+	store.lastWrite = time.Now()
+	store.dirty = false
+	return nil
+}
@@ -0,0 +1,247 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+)
+
+// TopicIncompleteReason tells why a chunked topic message was given up on
+type TopicIncompleteReason int
+
+const (
+	// TopicIncompleteExpired means the remaining chunks didn't arrive within the chunk group timeout
+	TopicIncompleteExpired TopicIncompleteReason = iota
+	// TopicIncompleteEvicted means the message was dropped to stay within the pending chunk limits
+	TopicIncompleteEvicted
+	// TopicIncompleteUnfinished means the subscription ended before the remaining chunks arrived
+	TopicIncompleteUnfinished
+)
+
+// String returns the name of the reason
+func (reason TopicIncompleteReason) String() string {
+	switch reason {
+	case TopicIncompleteExpired:
+		return "EXPIRED"
+	case TopicIncompleteEvicted:
+		return "EVICTED"
+	case TopicIncompleteUnfinished:
+		return "UNFINISHED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// TopicIncompleteMessage is a chunked topic message which was given up on before all of its chunks arrived
+type TopicIncompleteMessage struct {
+	// The ID of the transaction which submitted the first chunk of the message
+	TransactionID TransactionID
+	// The chunks which did arrive, ordered by their number
+	Chunks []TopicMessageChunk
+	// The number of chunks of the complete message
+	Total  int32
+	Reason TopicIncompleteReason
+}
+
+// The most chunks a topic message may claim to have. TopicMessageSubmitTransaction uses at most 20 by default,
+// larger totals are treated as malformed.
+const maxTopicMessageChunks = 1000
+
+type _TopicChunkGroup struct {
+	transactionID TransactionID
+	total         int32
+	started       time.Time
+	bytes         uint64
+	chunks        map[int32]*mirror.ConsensusTopicResponse
+}
+
+// _Chunks returns the chunks ordered by their number once every one of them arrived
+func (group *_TopicChunkGroup) _Chunks() ([]*mirror.ConsensusTopicResponse, bool) {
+	if int32(len(group.chunks)) != group.total {
+		return nil, false
+	}
+
+	chunks := make([]*mirror.ConsensusTopicResponse, 0, len(group.chunks))
+	for number := int32(1); number <= group.total; number++ {
+		chunk, ok := group.chunks[number]
+		if !ok || chunk == nil {
+			return nil, false
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, true
+}
+
+// _TopicChunkAssembler reassembles chunked topic messages while bounding the chunks it holds on to. Groups are
+// evicted oldest first once the group or byte limit is exceeded, and expire once the consensus timestamp of the
+// stream moved past the timeout since their first chunk. A limit of zero disables it.
+type _TopicChunkAssembler struct {
+	maxGroups    int
+	maxBytes     uint64
+	timeout      time.Duration
+	bytes        uint64
+	groups       map[string]*_TopicChunkGroup
+	order        []string
+	onIncomplete func(TopicIncompleteMessage)
+}
+
+func _NewTopicChunkAssembler(maxGroups int, maxBytes uint64, timeout time.Duration, onIncomplete func(TopicIncompleteMessage)) *_TopicChunkAssembler {
+	return &_TopicChunkAssembler{
+		maxGroups:    maxGroups,
+		maxBytes:     maxBytes,
+		timeout:      timeout,
+		groups:       make(map[string]*_TopicChunkGroup),
+		onIncomplete: onIncomplete,
+	}
+}
+
+// _Add adds a chunk, returning the message once it completed a group
+func (assembler *_TopicChunkAssembler) _Add(resp *mirror.ConsensusTopicResponse) (TopicMessage, bool) {
+	timestamp := _TimeFromProtobuf(resp.ConsensusTimestamp)
+	assembler._Expire(timestamp)
+
+	// Chunks come from the mirror node as submitted, so malformed ones are dropped rather than trusted
+	info := resp.ChunkInfo
+	if info.Total < 1 || info.Total > maxTopicMessageChunks || info.Number < 1 || info.Number > info.Total {
+		return TopicMessage{}, false
+	}
+
+	transactionID := _TransactionIDFromProtobuf(info.InitialTransactionID)
+	key := transactionID.String()
+
+	group, ok := assembler.groups[key]
+	if !ok {
+		group = &_TopicChunkGroup{
+			transactionID: transactionID,
+			total:         info.Total,
+			started:       timestamp,
+			chunks:        make(map[int32]*mirror.ConsensusTopicResponse),
+		}
+		assembler.groups[key] = group
+		assembler.order = append(assembler.order, key)
+	}
+
+	if info.Total != group.total {
+		return TopicMessage{}, false
+	}
+	if _, duplicate := group.chunks[info.Number]; duplicate {
+		return TopicMessage{}, false
+	}
+
+	group.chunks[info.Number] = resp
+	group.bytes += uint64(len(resp.Message))
+	assembler.bytes += uint64(len(resp.Message))
+
+	if chunks, complete := group._Chunks(); complete {
+		assembler._Remove(key)
+		return _TopicMessageOfMany(chunks), true
+	}
+
+	for len(assembler.order) > 0 &&
+		((assembler.maxGroups > 0 && len(assembler.groups) > assembler.maxGroups) ||
+			(assembler.maxBytes > 0 && assembler.bytes > assembler.maxBytes)) {
+		assembler._GiveUp(assembler.order[0], TopicIncompleteEvicted)
+	}
+
+	return TopicMessage{}, false
+}
+
+// _Expire gives up on the groups whose first chunk is older than the timeout at the consensus time now
+func (assembler *_TopicChunkAssembler) _Expire(now time.Time) {
+	if assembler.timeout <= 0 {
+		return
+	}
+
+	for _, key := range append([]string(nil), assembler.order...) {
+		if now.Sub(assembler.groups[key].started) > assembler.timeout {
+			assembler._GiveUp(key, TopicIncompleteExpired)
+		}
+	}
+}
+
+// _Oldest returns the pending chunk with the earliest consensus timestamp
+func (assembler *_TopicChunkAssembler) _Oldest() (*mirror.ConsensusTopicResponse, bool) {
+	var oldest *mirror.ConsensusTopicResponse
+	for _, group := range assembler.groups {
+		for _, chunk := range group.chunks {
+			if oldest == nil || _TimeFromProtobuf(chunk.ConsensusTimestamp).Before(_TimeFromProtobuf(oldest.ConsensusTimestamp)) {
+				oldest = chunk
+			}
+		}
+	}
+
+	return oldest, oldest != nil
+}
+
+// _Flush gives up on every pending group, as no more chunks are coming
+func (assembler *_TopicChunkAssembler) _Flush() {
+	for len(assembler.order) > 0 {
+		assembler._GiveUp(assembler.order[0], TopicIncompleteUnfinished)
+	}
+}
+
+func (assembler *_TopicChunkAssembler) _GiveUp(key string, reason TopicIncompleteReason) {
+	group := assembler.groups[key]
+	assembler._Remove(key)
+
+	if assembler.onIncomplete == nil {
+		return
+	}
+
+	numbers := make([]int32, 0, len(group.chunks))
+	for number := range group.chunks {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	chunks := make([]TopicMessageChunk, 0, len(group.chunks))
+	for _, number := range numbers {
+		chunks = append(chunks, _NewTopicMessageChunk(group.chunks[number]))
+	}
+
+	assembler.onIncomplete(TopicIncompleteMessage{
+		TransactionID: group.transactionID,
+		Chunks:        chunks,
+		Total:         group.total,
+		Reason:        reason,
+	})
+}
+
+func (assembler *_TopicChunkAssembler) _Remove(key string) {
+	group, ok := assembler.groups[key]
+	if !ok {
+		return
+	}
+
+	delete(assembler.groups, key)
+	assembler.bytes -= group.bytes
+
+	for i, pending := range assembler.order {
+		if pending == key {
+			assembler.order = append(assembler.order[:i], assembler.order[i+1:]...)
+			break
+		}
+	}
+}
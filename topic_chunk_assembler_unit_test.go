@@ -0,0 +1,214 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockTopicChunk(account uint64, number int32, total int32, seconds int64, contents string) *mirror.ConsensusTopicResponse {
+	transactionID := TransactionID{AccountID: &AccountID{Account: account}}
+
+	return &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: &services.Timestamp{Seconds: seconds},
+		Message:            []byte(contents),
+		SequenceNumber:     uint64(seconds),
+		ChunkInfo: &services.ConsensusMessageChunkInfo{
+			InitialTransactionID: transactionID._ToProtobuf(),
+			Number:               number,
+			Total:                total,
+		},
+	}
+}
+
+func TestUnitTopicChunkAssemblerReassembles(t *testing.T) {
+	t.Parallel()
+
+	assembler := _NewTopicChunkAssembler(0, 0, 0, nil)
+
+	_, ok := assembler._Add(_MockTopicChunk(1, 2, 2, 2, "world"))
+	require.False(t, ok)
+	_, ok = assembler._Add(_MockTopicChunk(1, 2, 2, 3, "world"))
+	require.False(t, ok)
+
+	message, ok := assembler._Add(_MockTopicChunk(1, 1, 2, 4, "hello "))
+	require.True(t, ok)
+	assert.Equal(t, "hello world", string(message.Contents))
+	assert.Len(t, message.Chunks, 2)
+	assert.Empty(t, assembler.groups)
+	assert.Zero(t, assembler.bytes)
+}
+
+func TestUnitTopicChunkAssemblerDropsMalformedChunks(t *testing.T) {
+	t.Parallel()
+
+	incomplete := make([]TopicIncompleteMessage, 0)
+	assembler := _NewTopicChunkAssembler(0, 0, 0, func(message TopicIncompleteMessage) {
+		incomplete = append(incomplete, message)
+	})
+
+	_, ok := assembler._Add(_MockTopicChunk(1, 1, 2, 1, "hello "))
+	require.False(t, ok)
+	_, ok = assembler._Add(_MockTopicChunk(1, 3, 5, 2, "mismatched total"))
+	require.False(t, ok)
+	_, ok = assembler._Add(_MockTopicChunk(1, 0, 2, 3, "number below range"))
+	require.False(t, ok)
+	_, ok = assembler._Add(_MockTopicChunk(2, 1, maxTopicMessageChunks+1, 4, "oversized total"))
+	require.False(t, ok)
+	assert.Len(t, assembler.groups, 1)
+
+	message, ok := assembler._Add(_MockTopicChunk(1, 2, 2, 5, "world"))
+	require.True(t, ok)
+	assert.Equal(t, "hello world", string(message.Contents))
+
+	_, ok = assembler._Add(_MockTopicChunk(3, 2, 1<<30, 6, "huge"))
+	require.False(t, ok)
+	assembler._Flush()
+	assert.Empty(t, incomplete)
+}
+
+func TestUnitTopicChunkAssemblerEvictsOldestGroup(t *testing.T) {
+	t.Parallel()
+
+	incomplete := make([]TopicIncompleteMessage, 0)
+	assembler := _NewTopicChunkAssembler(2, 0, 0, func(message TopicIncompleteMessage) {
+		incomplete = append(incomplete, message)
+	})
+
+	assembler._Add(_MockTopicChunk(1, 1, 2, 1, "a"))
+	assembler._Add(_MockTopicChunk(2, 1, 2, 2, "b"))
+	assembler._Add(_MockTopicChunk(3, 1, 2, 3, "c"))
+
+	require.Len(t, incomplete, 1)
+	assert.Equal(t, uint64(1), incomplete[0].TransactionID.AccountID.Account)
+	assert.Equal(t, TopicIncompleteEvicted, incomplete[0].Reason)
+	assert.Equal(t, int32(2), incomplete[0].Total)
+	require.Len(t, incomplete[0].Chunks, 1)
+	assert.Equal(t, []byte("a"), incomplete[0].Chunks[0].Contents)
+	assert.Len(t, assembler.groups, 2)
+}
+
+func TestUnitTopicChunkAssemblerEvictsOverMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	incomplete := make([]TopicIncompleteMessage, 0)
+	assembler := _NewTopicChunkAssembler(0, 4, 0, func(message TopicIncompleteMessage) {
+		incomplete = append(incomplete, message)
+	})
+
+	assembler._Add(_MockTopicChunk(1, 1, 2, 1, "aaa"))
+	assembler._Add(_MockTopicChunk(2, 1, 2, 2, "bb"))
+
+	require.Len(t, incomplete, 1)
+	assert.Equal(t, uint64(1), incomplete[0].TransactionID.AccountID.Account)
+	assert.Equal(t, uint64(2), assembler.bytes)
+}
+
+func TestUnitTopicChunkAssemblerExpiresInConsensusTime(t *testing.T) {
+	t.Parallel()
+
+	incomplete := make([]TopicIncompleteMessage, 0)
+	assembler := _NewTopicChunkAssembler(0, 0, 10*time.Second, func(message TopicIncompleteMessage) {
+		incomplete = append(incomplete, message)
+	})
+
+	assembler._Add(_MockTopicChunk(1, 1, 2, 100, "a"))
+	assembler._Add(_MockTopicChunk(2, 1, 2, 105, "b"))
+	require.Empty(t, incomplete)
+
+	// The chunk of the first message arrives too late, so it starts over as a new group
+	assembler._Add(_MockTopicChunk(1, 2, 2, 111, "a"))
+	require.Len(t, incomplete, 1)
+	assert.Equal(t, TopicIncompleteExpired, incomplete[0].Reason)
+	assert.Equal(t, uint64(1), incomplete[0].TransactionID.AccountID.Account)
+
+	assembler._Flush()
+	require.Len(t, incomplete, 3)
+	assert.Equal(t, TopicIncompleteUnfinished, incomplete[1].Reason)
+	assert.Equal(t, TopicIncompleteUnfinished, incomplete[2].Reason)
+	assert.Empty(t, assembler.groups)
+}
+
+func TestUnitTopicMessageQueryExpiresChunksOnSingleMessages(t *testing.T) {
+	t.Parallel()
+
+	single := _MockTopicResponse(101)
+	single.ConsensusTimestamp = &services.Timestamp{Seconds: 120}
+
+	responses := [][]interface{}{
+		{_MockTopicChunk(1, 1, 2, 100, "hello "), single},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	incomplete := make([]TopicIncompleteMessage, 0)
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetChunkGroupTimeout(10 * time.Second).
+		SetIncompleteMessageHandler(func(message TopicIncompleteMessage) {
+			incomplete = append(incomplete, message)
+		})
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 1)
+
+	// expired by the single chunk message rather than flushed when the subscription ended
+	require.Len(t, incomplete, 1)
+	assert.Equal(t, TopicIncompleteExpired, incomplete[0].Reason)
+}
+
+func TestUnitTopicMessageQueryRawChunkHandler(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicChunk(1, 1, 2, 1, "hello "), _MockTopicChunk(1, 2, 2, 2, "world"), _MockTopicResponse(3)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	chunks := make([]TopicMessageChunk, 0)
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetRawChunkHandler(func(chunk TopicMessageChunk) {
+			chunks = append(chunks, chunk)
+		})
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 1)
+	assert.Equal(t, uint64(3), messages[0].SequenceNumber)
+
+	require.Len(t, chunks, 2)
+	assert.Equal(t, int32(1), chunks[0].Number)
+	assert.Equal(t, int32(2), chunks[1].Total)
+	assert.Equal(t, []byte("world"), chunks[1].Contents)
+	require.NotNil(t, chunks[1].InitialTransactionID)
+}
@@ -90,6 +90,10 @@ func (id TopicID) _IsZero() bool {
 	return id.Shard == 0 && id.Realm == 0 && id.Topic == 0
 }
 
+func (id TopicID) _WithoutChecksum() TopicID {
+	return TopicID{Shard: id.Shard, Realm: id.Realm, Topic: id.Topic}
+}
+
 // String returns the string representation of a TopicID in `Shard.Realm.Topic` (for example "0.0.3")
 func (id TopicID) String() string {
 	return fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Topic)
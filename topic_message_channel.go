@@ -0,0 +1,192 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+)
+
+// TopicOverflowPolicy decides what TopicMessageQuery.SubscribeChan does with a message when its buffer is full
+type TopicOverflowPolicy int
+
+const (
+	// TopicOverflowBlock holds back the stream until the consumer makes room in the buffer
+	TopicOverflowBlock TopicOverflowPolicy = iota
+	// TopicOverflowDropOldest discards the oldest buffered message to make room for the new one
+	TopicOverflowDropOldest
+	// TopicOverflowError ends the subscription with ErrTopicSubscriptionOverflow
+	TopicOverflowError
+)
+
+// String returns the name of the policy
+func (policy TopicOverflowPolicy) String() string {
+	switch policy {
+	case TopicOverflowBlock:
+		return "BLOCK"
+	case TopicOverflowDropOldest:
+		return "DROP_OLDEST"
+	case TopicOverflowError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// SetOverflowPolicy sets what SubscribeChan does with a message when its buffer is full. Defaults to TopicOverflowBlock.
+func (query *TopicMessageQuery) SetOverflowPolicy(policy TopicOverflowPolicy) *TopicMessageQuery {
+	query.overflowPolicy = policy
+	return query
+}
+
+// GetOverflowPolicy returns what SubscribeChan does with a message when its buffer is full
+func (query *TopicMessageQuery) GetOverflowPolicy() TopicOverflowPolicy {
+	return query.overflowPolicy
+}
+
+// SubscribeChan subscribes to messages sent to the specific TopicID, delivering them on the returned channel, which
+// buffers up to bufferSize messages. The subscription runs until ctx is done, the stream completes or it fails, after
+// which both channels are closed. The error channel receives at most one error: the one the subscription failed with.
+// The error and completion handlers of the query aren't called.
+func (query *TopicMessageQuery) SubscribeChan(ctx context.Context, client *Client, bufferSize int) (<-chan TopicMessage, <-chan error) {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+
+	messages := make(chan TopicMessage, bufferSize)
+	errs := make(chan error, 1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	var overflow error
+
+	onNext := func(message TopicMessage) {
+		if overflow != nil {
+			return
+		}
+
+		switch query.overflowPolicy {
+		case TopicOverflowDropOldest:
+			for {
+				select {
+				case messages <- message:
+					return
+				default:
+				}
+
+				if cap(messages) == 0 {
+					// Nothing is buffered to drop, so the new message is the oldest
+					return
+				}
+
+				select {
+				case <-messages:
+				default:
+				}
+			}
+		case TopicOverflowError:
+			select {
+			case messages <- message:
+			default:
+				overflow = ErrTopicSubscriptionOverflow{BufferSize: bufferSize}
+				cancel()
+			}
+		default:
+			select {
+			case messages <- message:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	onDone := func(err error) {
+		defer cancel()
+		defer close(messages)
+		defer close(errs)
+
+		switch {
+		case overflow != nil:
+			errs <- overflow
+		case err == nil:
+		case ctx.Err() != nil:
+			// The caller's context is done, which isn't a failure of the subscription
+		default:
+			errs <- err
+		}
+	}
+
+	if err := query._Subscribe(ctx, client, onNext, onDone); err != nil {
+		onDone(err)
+	}
+
+	return messages, errs
+}
+
+// TopicMessageIterator pulls the messages of a topic subscription one at a time, holding back the stream while the
+// consumer is busy. Create it with TopicMessageQuery.Iterator.
+type TopicMessageIterator struct {
+	messages <-chan TopicMessage
+	errs     <-chan error
+	cancel   context.CancelFunc
+	item     TopicMessage
+	err      error
+}
+
+// Iterator subscribes to messages sent to the specific TopicID, buffering up to bufferSize messages which haven't been
+// pulled yet. The overflow policy of the query applies to the buffer. Call Close once done with the iterator.
+func (query *TopicMessageQuery) Iterator(ctx context.Context, client *Client, bufferSize int) *TopicMessageIterator {
+	ctx, cancel := context.WithCancel(ctx)
+	messages, errs := query.SubscribeChan(ctx, client, bufferSize)
+
+	return &TopicMessageIterator{
+		messages: messages,
+		errs:     errs,
+		cancel:   cancel,
+	}
+}
+
+// Next waits for the next message, returning false once the subscription ended. Check Err afterwards to tell
+// whether it failed.
+func (iterator *TopicMessageIterator) Next() bool {
+	message, ok := <-iterator.messages
+	if !ok {
+		if err, failed := <-iterator.errs; failed {
+			iterator.err = err
+		}
+		return false
+	}
+
+	iterator.item = message
+	return true
+}
+
+// Item returns the message the last call to Next moved to
+func (iterator *TopicMessageIterator) Item() TopicMessage {
+	return iterator.item
+}
+
+// Err returns the error the subscription failed with, if any
+func (iterator *TopicMessageIterator) Err() error {
+	return iterator.err
+}
+
+// Close ends the subscription
+func (iterator *TopicMessageIterator) Close() {
+	iterator.cancel()
+}
@@ -0,0 +1,139 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTopicMessageQuerySubscribeChan(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicResponse(1), _MockTopicResponse(2), _MockTopicResponse(3)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	messages, errs := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SubscribeChan(context.Background(), client, 0)
+
+	sequenceNumbers := make([]uint64, 0)
+	for message := range messages {
+		sequenceNumbers = append(sequenceNumbers, message.SequenceNumber)
+	}
+
+	require.NoError(t, <-errs)
+	assert.Equal(t, []uint64{1, 2, 3}, sequenceNumbers)
+}
+
+func TestUnitTopicMessageQuerySubscribeChanDropOldest(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicResponse(1), _MockTopicResponse(2), _MockTopicResponse(3)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	messages, errs := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetOverflowPolicy(TopicOverflowDropOldest).
+		SubscribeChan(context.Background(), client, 1)
+
+	// The error channel closes once the subscription ended, leaving only the newest message buffered
+	require.NoError(t, <-errs)
+
+	sequenceNumbers := make([]uint64, 0)
+	for message := range messages {
+		sequenceNumbers = append(sequenceNumbers, message.SequenceNumber)
+	}
+	assert.Equal(t, []uint64{3}, sequenceNumbers)
+}
+
+func TestUnitTopicMessageQuerySubscribeChanOverflowError(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicResponse(1), _MockTopicResponse(2), _MockTopicResponse(3)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	messages, errs := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetOverflowPolicy(TopicOverflowError).
+		SubscribeChan(context.Background(), client, 1)
+
+	err := <-errs
+	require.ErrorIs(t, err, ErrTopicSubscriptionOverflow{BufferSize: 1})
+
+	message, ok := <-messages
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), message.SequenceNumber)
+}
+
+func TestUnitTopicMessageQuerySubscribeChanInvalidClient(t *testing.T) {
+	t.Parallel()
+
+	messages, errs := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SubscribeChan(context.Background(), nil, 1)
+
+	require.ErrorIs(t, <-errs, errNoClientProvided)
+	_, ok := <-messages
+	require.False(t, ok)
+}
+
+func TestUnitTopicMessageIterator(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicResponse(1), _MockTopicResponse(2)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	iterator := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		Iterator(context.Background(), client, 1)
+	defer iterator.Close()
+
+	sequenceNumbers := make([]uint64, 0)
+	for iterator.Next() {
+		sequenceNumbers = append(sequenceNumbers, iterator.Item().SequenceNumber)
+	}
+
+	require.NoError(t, iterator.Err())
+	assert.Equal(t, []uint64{1, 2}, sequenceNumbers)
+}
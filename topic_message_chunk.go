@@ -32,13 +32,34 @@ type TopicMessageChunk struct {
 	ContentSize        uint64
 	RunningHash        []byte
 	SequenceNumber     uint64
+	Contents           []byte
+	// The position of the chunk in its message, starting at 1
+	Number int32
+	// The number of chunks of the message
+	Total int32
+	// The ID of the transaction which submitted the first chunk of the message
+	InitialTransactionID *TransactionID
 }
 
 func _NewTopicMessageChunk(resp *mirror.ConsensusTopicResponse) TopicMessageChunk {
-	return TopicMessageChunk{
+	chunk := TopicMessageChunk{
 		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
 		ContentSize:        uint64(len(resp.Message)),
 		RunningHash:        resp.RunningHash,
 		SequenceNumber:     resp.SequenceNumber,
+		Contents:           resp.Message,
+		Number:             1,
+		Total:              1,
 	}
+
+	if resp.ChunkInfo != nil {
+		chunk.Number = resp.ChunkInfo.Number
+		chunk.Total = resp.ChunkInfo.Total
+		if resp.ChunkInfo.InitialTransactionID != nil {
+			transactionID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID)
+			chunk.InitialTransactionID = &transactionID
+		}
+	}
+
+	return chunk
 }
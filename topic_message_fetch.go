@@ -0,0 +1,177 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// the maximum page size of the mirror node REST API
+const topicMessagePageSize = "100"
+
+// Fetch reads the messages of the topic with a consensus timestamp from start up to, but excluding, end from the mirror
+// node REST API, reassembling chunked messages. A zero end reads up to the latest message. The limit of the query
+// applies, while its start time, end time and checkpoint store don't. Every message is held in memory, use FetchEach
+// for large ranges.
+//
+// To continue with the messages submitted afterwards, subscribe with the start time set right after the consensus
+// timestamp of the last fetched message, or use SetRestBackfill to let Subscribe do both.
+func (query *TopicMessageQuery) Fetch(client *Client, start time.Time, end time.Time) ([]TopicMessage, error) {
+	messages := make([]TopicMessage, 0)
+	err := query.FetchEach(client, start, end, func(message TopicMessage) {
+		messages = append(messages, message)
+	})
+
+	return messages, err
+}
+
+// FetchEach reads the same messages as Fetch, passing each one to onNext in consensus order as its page arrives
+// instead of collecting them. Messages passed to onNext before an error occurred remain delivered.
+func (query *TopicMessageQuery) FetchEach(client *Client, start time.Time, end time.Time, onNext func(TopicMessage)) error {
+	if client == nil {
+		return errNoClientProvided
+	}
+
+	if query.topicID == nil {
+		return errors.New("topic ID must be set")
+	}
+
+	if err := query.validateNetworkOnIDs(client); err != nil {
+		return err
+	}
+
+	pb := query.build()
+	pb.ConsensusStartTime = &services.Timestamp{}
+	if !start.IsZero() {
+		pb.ConsensusStartTime = _TimeToProtobuf(start)
+	}
+	pb.ConsensusEndTime = nil
+	if !end.IsZero() {
+		pb.ConsensusEndTime = _TimeToProtobuf(end)
+	}
+
+	subscription := &_TopicSubscription{
+		client:  client,
+		pb:      pb,
+		limited: query.limit > 0,
+	}
+	subscription.chunks = _NewTopicChunkAssembler(query.maxPendingChunkGroups, query.maxPendingChunkBytes, query.chunkGroupTimeout,
+		func(message TopicIncompleteMessage) {
+			query._OnIncomplete(subscription, message)
+		})
+
+	err := query._Backfill(context.Background(), subscription, onNext)
+	subscription.chunks._Flush()
+
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// _Backfill delivers the messages from the start time of the subscription up to its end time, or the latest message,
+// from the mirror node REST API. It returns io.EOF once the limit of the query is reached.
+func (query *TopicMessageQuery) _Backfill(ctx context.Context, subscription *_TopicSubscription, onNext func(TopicMessage)) error {
+	if query.topicID == nil {
+		return errors.New("topic ID must be set")
+	}
+
+	params := url.Values{}
+	params.Set("order", "asc")
+	params.Set("limit", topicMessagePageSize)
+	params.Add("timestamp", "gte:"+string(MirrorTimestampFromTime(_TimeFromProtobuf(subscription.pb.ConsensusStartTime))))
+	if subscription.pb.ConsensusEndTime != nil {
+		params.Add("timestamp", "lt:"+string(MirrorTimestampFromTime(_TimeFromProtobuf(subscription.pb.ConsensusEndTime))))
+	}
+
+	iterator := MirrorClientFromClient(subscription.client).
+		ListTopicMessages(ctx, query.topicID._WithoutChecksum().String(), params)
+
+	for iterator.Next() {
+		resp, err := _TopicResponseFromMirror(iterator.Item())
+		if err != nil {
+			return err
+		}
+
+		if query._Process(subscription, resp, onNext) {
+			return io.EOF
+		}
+	}
+
+	return iterator.Err()
+}
+
+// _TopicResponseFromMirror converts a message of the mirror node REST API to the one of the stream
+func _TopicResponseFromMirror(message MirrorTopicMessage) (*mirror.ConsensusTopicResponse, error) {
+	timestamp, err := message.ConsensusTimestamp.Time()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: _TimeToProtobuf(timestamp),
+		Message:            message.Message,
+		RunningHash:        message.RunningHash,
+		SequenceNumber:     message.SequenceNumber,
+		RunningHashVersion: uint64(message.RunningHashVersion),
+	}
+
+	if message.ChunkInfo == nil {
+		return resp, nil
+	}
+
+	initial := message.ChunkInfo.InitialTransactionID
+	payer, err := AccountIDFromString(initial.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	validStart, err := initial.TransactionValidStart.Time()
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID := TransactionID{
+		AccountID:  &payer,
+		ValidStart: &validStart,
+		scheduled:  initial.Scheduled,
+	}
+	if initial.Nonce != 0 {
+		nonce := initial.Nonce
+		transactionID.Nonce = &nonce
+	}
+
+	resp.ChunkInfo = &services.ConsensusMessageChunkInfo{
+		InitialTransactionID: transactionID._ToProtobuf(),
+		Number:               message.ChunkInfo.Number,
+		Total:                message.ChunkInfo.Total,
+	}
+
+	return resp, nil
+}
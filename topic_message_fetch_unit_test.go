@@ -0,0 +1,133 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTopicMessageQueryFetch(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/topics/0.0.5/messages", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("page") == "" {
+			require.Equal(t, "asc", r.URL.Query().Get("order"))
+			require.Equal(t, []string{"gte:1700000000.000000000", "lt:1800000000.000000000"}, r.URL.Query()["timestamp"])
+			_, _ = w.Write([]byte(`{"messages":[{"chunk_info":{"initial_transaction_id":{"account_id":"0.0.2","nonce":0,"scheduled":false,"transaction_valid_start":"1700000000.000000001"},"number":1,"total":2},"consensus_timestamp":"1700000001.000000000","message":"aGVsbG8g","running_hash":"AQ==","sequence_number":1,"topic_id":"0.0.5"}],"links":{"next":"/api/v1/topics/0.0.5/messages?page=2"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"messages":[{"chunk_info":{"initial_transaction_id":{"account_id":"0.0.2","nonce":0,"scheduled":false,"transaction_valid_start":"1700000000.000000001"},"number":2,"total":2},"consensus_timestamp":"1700000002.000000000","message":"d29ybGQ=","running_hash":"Ag==","sequence_number":2,"topic_id":"0.0.5"},{"consensus_timestamp":"1700000003.000000000","message":"IQ==","running_hash":"Aw==","sequence_number":3,"topic_id":"0.0.5"}],"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	messages, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		Fetch(client, time.Unix(1700000000, 0), time.Unix(1800000000, 0))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	assert.Equal(t, "hello world", string(messages[0].Contents))
+	assert.Equal(t, uint64(2), messages[0].SequenceNumber)
+	require.NotNil(t, messages[0].TransactionID)
+	assert.Equal(t, AccountID{Account: 2}, *messages[0].TransactionID.AccountID)
+	assert.Equal(t, time.Unix(1700000000, 1), *messages[0].TransactionID.ValidStart)
+
+	assert.Equal(t, "!", string(messages[1].Contents))
+	assert.Equal(t, []byte{3}, messages[1].RunningHash)
+	assert.True(t, messages[1].ConsensusTimestamp.Equal(time.Unix(1700000003, 0)))
+}
+
+func TestUnitTopicMessageQueryFetchEach(t *testing.T) {
+	t.Parallel()
+
+	delivered := make([]uint64, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("page") == "" {
+			_, _ = w.Write([]byte(`{"messages":[{"consensus_timestamp":"1001.000000000","message":"AQ==","sequence_number":1}],"links":{"next":"/api/v1/topics/0.0.5/messages?page=2"}}`))
+			return
+		}
+
+		// The first page is delivered before the next one is requested
+		assert.Equal(t, []uint64{1}, delivered)
+		_, _ = w.Write([]byte(`{"messages":[{"consensus_timestamp":"1002.000000000","message":"Ag==","sequence_number":2}],"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	err = NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		FetchEach(client, time.Time{}, time.Time{}, func(message TopicMessage) {
+			delivered = append(delivered, message.SequenceNumber)
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, delivered)
+}
+
+func TestUnitTopicMessageQueryRestBackfill(t *testing.T) {
+	t.Parallel()
+
+	rest := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"messages":[{"consensus_timestamp":"1001.000000000","message":"AQ==","sequence_number":1},{"consensus_timestamp":"1002.000000000","message":"Ag==","sequence_number":2}],"links":{"next":null}}`))
+	}))
+	defer rest.Close()
+
+	// The stream starts at the last message of the backfill, which is dropped as a duplicate
+	responses := [][]interface{}{
+		{_MockTopicResponse(2), _MockTopicResponse(3)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+	client.SetMirrorRestURL(rest.URL)
+
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetRestBackfill(true)
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 3)
+	for i, message := range messages {
+		assert.Equal(t, uint64(i+1), message.SequenceNumber)
+	}
+}
@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"context"
+	"errors"
 	"io"
 	"math"
 	"regexp"
@@ -43,23 +44,39 @@ type TopicMessageQuery struct {
 	errorHandler      func(stat status.Status)
 	completionHandler func()
 	retryHandler      func(err error) bool
-	attempt           uint64
+	gapHandler        func(expected uint64, received uint64)
 	maxAttempts       uint64
+	minBackoff        time.Duration
+	maxBackoff        time.Duration
 	topicID           *TopicID
 	startTime         *time.Time
 	endTime           *time.Time
 	limit             uint64
-	mu                sync.Mutex
+	checkpointStore   CheckpointStore
+	overflowPolicy    TopicOverflowPolicy
+	restBackfill      bool
+	// Bounds of the chunks held on to while reassembling chunked messages
+	maxPendingChunkGroups    int
+	maxPendingChunkBytes     uint64
+	chunkGroupTimeout        time.Duration
+	incompleteMessageHandler func(TopicIncompleteMessage)
+	rawChunkHandler          func(TopicMessageChunk)
+	mu                       sync.Mutex
 }
 
 // NewTopicMessageQuery creates TopicMessageQuery which
 // listens to messages sent to the specific TopicID
 func NewTopicMessageQuery() *TopicMessageQuery {
 	return &TopicMessageQuery{
-		maxAttempts:       maxAttempts,
-		errorHandler:      _DefaultErrorHandler,
-		retryHandler:      _DefaultRetryHandler,
-		completionHandler: _DefaultCompletionHandler,
+		maxAttempts:           maxAttempts,
+		minBackoff:            250 * time.Millisecond,
+		maxBackoff:            8 * time.Second,
+		maxPendingChunkGroups: 1000,
+		maxPendingChunkBytes:  16 * 1024 * 1024,
+		chunkGroupTimeout:     5 * time.Minute,
+		errorHandler:          _DefaultErrorHandler,
+		retryHandler:          _DefaultRetryHandler,
+		completionHandler:     _DefaultCompletionHandler,
 	}
 }
 
@@ -132,6 +149,114 @@ func (query *TopicMessageQuery) GetMaxAttempts() uint64 {
 	return query.maxAttempts
 }
 
+// SetMinBackoff sets the time to wait before the first attempt to resubscribe after a failure
+func (query *TopicMessageQuery) SetMinBackoff(min time.Duration) *TopicMessageQuery {
+	query.minBackoff = min
+	return query
+}
+
+// GetMinBackoff returns the time to wait before the first attempt to resubscribe after a failure
+func (query *TopicMessageQuery) GetMinBackoff() time.Duration {
+	return query.minBackoff
+}
+
+// SetMaxBackoff sets the maximum time to wait between attempts to resubscribe. The wait doubles
+// with every consecutive failed attempt until it reaches this time.
+func (query *TopicMessageQuery) SetMaxBackoff(max time.Duration) *TopicMessageQuery {
+	query.maxBackoff = max
+	return query
+}
+
+// GetMaxBackoff returns the maximum time to wait between attempts to resubscribe
+func (query *TopicMessageQuery) GetMaxBackoff() time.Duration {
+	return query.maxBackoff
+}
+
+// SetCheckpointStore sets the store the position of the subscription is saved to after every
+// delivered message. When the store holds a checkpoint for the topic, Subscribe resumes right after
+// it instead of at the start time. The checkpoint never moves past a chunk of a message which is still
+// incomplete, so messages delivered after that chunk may be delivered again on resume.
+func (query *TopicMessageQuery) SetCheckpointStore(store CheckpointStore) *TopicMessageQuery {
+	query.checkpointStore = store
+	return query
+}
+
+// GetCheckpointStore returns the store the position of the subscription is saved to
+func (query *TopicMessageQuery) GetCheckpointStore() CheckpointStore {
+	return query.checkpointStore
+}
+
+// SetGapHandler sets the handler called when the sequence number of a received message isn't the one
+// following the previous message, meaning messages were missed. Messages with a sequence number which
+// was already received are dropped as duplicates.
+func (query *TopicMessageQuery) SetGapHandler(gapHandler func(expected uint64, received uint64)) *TopicMessageQuery {
+	query.gapHandler = gapHandler
+	return query
+}
+
+// SetRestBackfill sets whether Subscribe first reads the messages which were already submitted from the mirror node
+// REST API, which is much faster for large backlogs, before switching over to the stream right after the last of them.
+func (query *TopicMessageQuery) SetRestBackfill(backfill bool) *TopicMessageQuery {
+	query.restBackfill = backfill
+	return query
+}
+
+// GetRestBackfill returns whether Subscribe first reads the messages which were already submitted from the mirror node REST API
+func (query *TopicMessageQuery) GetRestBackfill() bool {
+	return query.restBackfill
+}
+
+// SetMaxPendingChunkGroups sets the maximum number of chunked messages which are waiting for more chunks. Once it is
+// exceeded, the message which started waiting first is given up on. Zero means no limit. Defaults to 1000.
+func (query *TopicMessageQuery) SetMaxPendingChunkGroups(max int) *TopicMessageQuery {
+	query.maxPendingChunkGroups = max
+	return query
+}
+
+// GetMaxPendingChunkGroups returns the maximum number of chunked messages which are waiting for more chunks
+func (query *TopicMessageQuery) GetMaxPendingChunkGroups() int {
+	return query.maxPendingChunkGroups
+}
+
+// SetMaxPendingChunkBytes sets the maximum size of the contents of all chunks waiting for the rest of their message.
+// Once it is exceeded, the messages which started waiting first are given up on. Zero means no limit. Defaults to 16 MiB.
+func (query *TopicMessageQuery) SetMaxPendingChunkBytes(max uint64) *TopicMessageQuery {
+	query.maxPendingChunkBytes = max
+	return query
+}
+
+// GetMaxPendingChunkBytes returns the maximum size of the contents of all chunks waiting for the rest of their message
+func (query *TopicMessageQuery) GetMaxPendingChunkBytes() uint64 {
+	return query.maxPendingChunkBytes
+}
+
+// SetChunkGroupTimeout sets how long a chunked message waits for its remaining chunks, measured in consensus time from
+// its first chunk, before it is given up on. Zero means no timeout. Defaults to 5 minutes.
+func (query *TopicMessageQuery) SetChunkGroupTimeout(timeout time.Duration) *TopicMessageQuery {
+	query.chunkGroupTimeout = timeout
+	return query
+}
+
+// GetChunkGroupTimeout returns how long a chunked message waits for its remaining chunks
+func (query *TopicMessageQuery) GetChunkGroupTimeout() time.Duration {
+	return query.chunkGroupTimeout
+}
+
+// SetIncompleteMessageHandler sets the handler called with the chunks of a message which was given up on, because it
+// expired, was evicted to stay within the limits, or the subscription ended before it completed. By default these
+// messages are logged.
+func (query *TopicMessageQuery) SetIncompleteMessageHandler(handler func(TopicIncompleteMessage)) *TopicMessageQuery {
+	query.incompleteMessageHandler = handler
+	return query
+}
+
+// SetRawChunkHandler sets the handler every chunk of a chunked message is passed to as it arrives, instead of
+// reassembling the message and passing it to onNext. Messages of a single chunk are still passed to onNext.
+func (query *TopicMessageQuery) SetRawChunkHandler(handler func(TopicMessageChunk)) *TopicMessageQuery {
+	query.rawChunkHandler = handler
+	return query
+}
+
 // SetErrorHandler Sets the error handler for this query
 func (query *TopicMessageQuery) SetErrorHandler(errorHandler func(stat status.Status)) *TopicMessageQuery {
 	query.errorHandler = errorHandler
@@ -185,105 +310,287 @@ func (query *TopicMessageQuery) build() *mirror.ConsensusTopicQuery {
 	return body
 }
 
-// Subscribe subscribes to messages sent to the specific TopicID
+// Subscribe subscribes to messages sent to the specific TopicID. On failure the subscription moves on to the
+// next mirror node of the client, resuming after the last received message.
 func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
-	var once sync.Once
-	done := make(chan struct{})
-	handle := SubscriptionHandle{}
-
-	err := query.validateNetworkOnIDs(client)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := query._Subscribe(ctx, client, onNext, func(err error) {
+		switch {
+		case ctx.Err() != nil:
+			// Unsubscribed
+		case err == nil:
+			query.completionHandler()
+		default:
+			query.errorHandler(*status.Convert(err))
+		}
+	})
 	if err != nil {
+		cancel()
 		return SubscriptionHandle{}, err
 	}
 
-	pb := query.build()
+	return SubscriptionHandle{onUnsubscribe: cancel}, nil
+}
 
-	messages := make(map[string][]*mirror.ConsensusTopicResponse)
+// _Subscribe starts the subscription in the background until ctx is done. Once it stops, onDone is called with
+// nil if the subscription completed, or with the error it failed with.
+func (query *TopicMessageQuery) _Subscribe(ctx context.Context, client *Client, onNext func(TopicMessage), onDone func(error)) error {
+	err := query.validateNetworkOnIDs(client)
+	if err != nil {
+		return err
+	}
 
-	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetConsensusServiceClient()
+	subscription, err := query._NewSubscription(client)
 	if err != nil {
-		return handle, err
+		return err
 	}
 
+	started := make(chan struct{})
+
 	go func() {
 		query.mu.Lock()
 		defer query.mu.Unlock()
-		var subClient mirror.ConsensusService_SubscribeTopicClient
-		var err error
-
-		for {
-			if err != nil {
-				handle.Unsubscribe()
-
-				if grpcErr, ok := status.FromError(err); ok { // nolint
-					if query.attempt < query.maxAttempts && query.retryHandler(err) {
-						subClient = nil
-
-						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
-						time.Sleep(time.Duration(delay) * time.Millisecond)
-						query.attempt++
-					} else {
-						query.errorHandler(*grpcErr)
-						break
-					}
-				} else if err == io.EOF {
-					query.completionHandler()
-					break
-				} else {
-					panic(err)
-				}
-			}
 
-			if subClient == nil {
-				ctx, cancel := context.WithCancel(context.TODO())
-				handle.onUnsubscribe = cancel
-				once.Do(func() {
-					close(done)
-				})
-				subClient, err = (*channel).SubscribeTopic(ctx, pb)
-
-				if err != nil {
-					continue
-				}
-			}
+		err := query._Run(ctx, subscription, started, onNext)
+		subscription.chunks._Flush()
+		query._FlushCheckpoints(subscription)
+		onDone(err)
+	}()
+
+	<-started
+	return nil
+}
 
-			var resp *mirror.ConsensusTopicResponse
-			resp, err = subClient.Recv()
+// _TopicSubscription is the state of a running subscription, carried over when it moves between mirror nodes
+type _TopicSubscription struct {
+	client             *Client
+	pb                 *mirror.ConsensusTopicQuery
+	nodes              []*_MirrorNode
+	node               int
+	limited            bool
+	checkpoints        bool
+	lastSequenceNumber uint64
+	chunks             *_TopicChunkAssembler
+}
 
-			if err != nil {
-				continue
-			}
+func (query *TopicMessageQuery) _NewSubscription(client *Client) (*_TopicSubscription, error) {
+	if client == nil {
+		return nil, errNoClientProvided
+	}
 
-			if resp.ConsensusTimestamp != nil {
-				pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
-			}
+	nodes := client.mirrorNetwork._GetMirrorNodes()
+	if len(nodes) == 0 {
+		return nil, errors.New("mirror network is not set")
+	}
 
-			if pb.Limit > 0 {
-				pb.Limit--
+	subscription := &_TopicSubscription{
+		client:      client,
+		pb:          query.build(),
+		nodes:       nodes,
+		limited:     query.limit > 0,
+		checkpoints: query.checkpointStore != nil && query.topicID != nil,
+	}
+	subscription.chunks = _NewTopicChunkAssembler(query.maxPendingChunkGroups, query.maxPendingChunkBytes, query.chunkGroupTimeout,
+		func(message TopicIncompleteMessage) {
+			query._OnIncomplete(subscription, message)
+		})
+
+	if query.checkpointStore != nil && query.topicID != nil {
+		checkpoint, err := query.checkpointStore.Load(*query.topicID)
+		if err != nil {
+			return nil, err
+		}
+		if checkpoint != nil {
+			resumeAt := checkpoint.ConsensusTimestamp.Add(time.Nanosecond)
+			if resumeAt.After(_TimeFromProtobuf(subscription.pb.ConsensusStartTime)) {
+				subscription.pb.ConsensusStartTime = _TimeToProtobuf(resumeAt)
 			}
+			subscription.lastSequenceNumber = checkpoint.SequenceNumber
+		}
+	}
 
-			if resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1 {
-				onNext(_TopicMessageOfSingle(resp))
-			} else {
-				txID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID).String()
-				message, ok := messages[txID]
-				if !ok {
-					message = make([]*mirror.ConsensusTopicResponse, 0, resp.ChunkInfo.Total)
-				}
+	return subscription, nil
+}
 
-				message = append(message, resp)
-				messages[txID] = message
+// _Run streams the topic, moving between mirror nodes on failure. It returns nil once the stream completed,
+// the error of ctx once it is done, or the error the stream failed with for good.
+func (query *TopicMessageQuery) _Run(ctx context.Context, subscription *_TopicSubscription, started chan struct{}, onNext func(TopicMessage)) error {
+	var once sync.Once
+	markStarted := func() {
+		once.Do(func() {
+			close(started)
+		})
+	}
+	defer markStarted()
+
+	if query.restBackfill {
+		markStarted()
+
+		err := query._Backfill(ctx, subscription, onNext)
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err == io.EOF || (err == nil && query.endTime != nil):
+			return nil
+		case err != nil:
+			subscription.client.logger.Warn("failed to backfill topic messages from the mirror node REST API, continuing with the stream",
+				"error", err.Error())
+		}
+	}
 
-				if int32(len(message)) == resp.ChunkInfo.Total {
-					delete(messages, txID)
+	attempt := uint64(0)
+	for {
+		node := subscription.nodes[subscription.node%len(subscription.nodes)]
 
-					onNext(_TopicMessageOfMany(message))
-				}
+		channel, err := node._GetConsensusServiceClient()
+		if err == nil {
+			var stream mirror.ConsensusService_SubscribeTopicClient
+			stream, err = (*channel).SubscribeTopic(ctx, subscription.pb)
+			markStarted()
+
+			if err == nil {
+				err = query._Receive(stream, subscription, &attempt, onNext)
 			}
 		}
-	}()
-	<-done
-	return handle, nil
+
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err == io.EOF || (subscription.limited && subscription.pb.Limit == 0):
+			return nil
+		case attempt >= query.maxAttempts || !query.retryHandler(err):
+			return err
+		}
+
+		delay := time.Duration(math.Min(float64(query.minBackoff)*math.Pow(2, float64(attempt)), float64(query.maxBackoff)))
+		attempt++
+		subscription.node++
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// _Receive delivers the messages of stream until it fails or ends
+func (query *TopicMessageQuery) _Receive(stream mirror.ConsensusService_SubscribeTopicClient, subscription *_TopicSubscription, attempt *uint64, onNext func(TopicMessage)) error {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		// The stream works again, so a later failure starts over with the shortest backoff
+		*attempt = 0
+
+		if query._Process(subscription, resp, onNext) {
+			return io.EOF
+		}
+	}
+}
+
+// _Process delivers a message of the topic, returning whether the limit of the query has been reached
+func (query *TopicMessageQuery) _Process(subscription *_TopicSubscription, resp *mirror.ConsensusTopicResponse, onNext func(TopicMessage)) bool {
+	if subscription.lastSequenceNumber > 0 {
+		if resp.SequenceNumber <= subscription.lastSequenceNumber {
+			return false
+		}
+		if resp.SequenceNumber != subscription.lastSequenceNumber+1 {
+			query._OnGap(subscription, subscription.lastSequenceNumber+1, resp.SequenceNumber)
+		}
+	}
+	subscription.lastSequenceNumber = resp.SequenceNumber
+
+	if resp.ConsensusTimestamp != nil {
+		subscription.pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
+
+		// Every message moves the consensus time of the stream forward, not only the chunks of other messages
+		subscription.chunks._Expire(_TimeFromProtobuf(resp.ConsensusTimestamp))
+	}
+
+	if subscription.pb.Limit > 0 {
+		subscription.pb.Limit--
+	}
+
+	switch {
+	case resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1:
+		query._Deliver(subscription, _TopicMessageOfSingle(resp), onNext)
+	case query.rawChunkHandler != nil:
+		chunk := _NewTopicMessageChunk(resp)
+		query.rawChunkHandler(chunk)
+		query._Checkpoint(subscription, chunk.ConsensusTimestamp, chunk.SequenceNumber)
+	default:
+		if message, ok := subscription.chunks._Add(resp); ok {
+			query._Deliver(subscription, message, onNext)
+		}
+	}
+
+	return subscription.limited && subscription.pb.Limit == 0
+}
+
+func (query *TopicMessageQuery) _Deliver(subscription *_TopicSubscription, message TopicMessage, onNext func(TopicMessage)) {
+	onNext(message)
+	query._Checkpoint(subscription, message.ConsensusTimestamp, message.SequenceNumber)
+}
+
+func (query *TopicMessageQuery) _Checkpoint(subscription *_TopicSubscription, timestamp time.Time, sequenceNumber uint64) {
+	if !subscription.checkpoints {
+		return
+	}
+
+	// Resuming past a chunk which is still waiting for the rest of its message would skip it for good
+	if oldest, ok := subscription.chunks._Oldest(); ok {
+		pending := _TimeFromProtobuf(oldest.ConsensusTimestamp).Add(-time.Nanosecond)
+		if pending.Before(timestamp) {
+			timestamp = pending
+			sequenceNumber = oldest.SequenceNumber - 1
+		}
+	}
+
+	err := query.checkpointStore.Save(TopicCheckpoint{
+		TopicID:            query.topicID._WithoutChecksum(),
+		ConsensusTimestamp: timestamp,
+		SequenceNumber:     sequenceNumber,
+	})
+	if err != nil {
+		subscription.client.logger.Error("failed to save topic checkpoint", "topicID", query.topicID.String(), "error", err.Error())
+	}
+}
+
+func (query *TopicMessageQuery) _FlushCheckpoints(subscription *_TopicSubscription) {
+	if !subscription.checkpoints {
+		return
+	}
+
+	if flusher, ok := query.checkpointStore.(_CheckpointFlusher); ok {
+		if err := flusher.Flush(); err != nil {
+			subscription.client.logger.Error("failed to flush topic checkpoints", "topicID", query.topicID.String(), "error", err.Error())
+		}
+	}
+}
+
+func (query *TopicMessageQuery) _OnIncomplete(subscription *_TopicSubscription, message TopicIncompleteMessage) {
+	if query.incompleteMessageHandler != nil {
+		query.incompleteMessageHandler(message)
+		return
+	}
+
+	subscription.client.logger.Warn("dropped incomplete topic message", "transactionID", message.TransactionID.String(),
+		"chunks", len(message.Chunks), "total", message.Total, "reason", message.Reason.String())
+}
+
+func (query *TopicMessageQuery) _OnGap(subscription *_TopicSubscription, expected uint64, received uint64) {
+	if query.gapHandler != nil {
+		query.gapHandler(expected, received)
+		return
+	}
+
+	subscription.client.logger.Warn("missed topic messages", "expected", expected, "received", received)
 }
 
 func _DefaultErrorHandler(stat status.Status) {
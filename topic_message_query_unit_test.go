@@ -24,11 +24,16 @@ package hedera
  */
 
 import (
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -102,3 +107,224 @@ func TestUnitTopicMessageQueryNothingSet(t *testing.T) {
 	balance.GetEndTime()
 	balance.GetLimit()
 }
+
+func _MockTopicResponse(sequenceNumber uint64) *mirror.ConsensusTopicResponse {
+	return &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: &services.Timestamp{Seconds: 1000 + int64(sequenceNumber)},
+		Message:            []byte{byte(sequenceNumber)},
+		RunningHash:        []byte{1},
+		SequenceNumber:     sequenceNumber,
+	}
+}
+
+func _SubscribeAndWait(t *testing.T, client *Client, query *TopicMessageQuery) []TopicMessage {
+	var mu sync.Mutex
+	messages := make([]TopicMessage, 0)
+	done := make(chan struct{})
+
+	query.
+		SetCompletionHandler(func() {
+			close(done)
+		}).
+		SetErrorHandler(func(stat status.Status) {
+			t.Errorf("unexpected subscription error: %s", stat.Message())
+			close(done)
+		})
+
+	handle, err := query.Subscribe(client, func(message TopicMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, message)
+	})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return messages
+}
+
+func TestUnitTopicMessageQueryFailsOverToNextMirror(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{status.Error(codes.Unavailable, "mirror unavailable")},
+		{_MockTopicResponse(1), _MockTopicResponse(2)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetMinBackoff(time.Millisecond).
+		SetMaxBackoff(10 * time.Millisecond)
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 2)
+	assert.Equal(t, uint64(1), messages[0].SequenceNumber)
+	assert.Equal(t, uint64(2), messages[1].SequenceNumber)
+}
+
+func TestUnitTopicMessageQueryResumesFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicResponse(1), _MockTopicResponse(2), _MockTopicResponse(3)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	topicID := TopicID{Topic: 5}
+	store := NewMemoryCheckpointStore()
+	require.NoError(t, store.Save(TopicCheckpoint{
+		TopicID:            topicID,
+		ConsensusTimestamp: time.Unix(1002, 0),
+		SequenceNumber:     2,
+	}))
+
+	query := NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetCheckpointStore(store)
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 1)
+	assert.Equal(t, uint64(3), messages[0].SequenceNumber)
+
+	checkpoint, err := store.Load(topicID)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, uint64(3), checkpoint.SequenceNumber)
+	assert.True(t, checkpoint.ConsensusTimestamp.Equal(time.Unix(1003, 0)))
+}
+
+func TestUnitTopicMessageQueryCheckpointsBeforePendingChunks(t *testing.T) {
+	t.Parallel()
+
+	single := func(sequenceNumber uint64) *mirror.ConsensusTopicResponse {
+		resp := _MockTopicResponse(sequenceNumber)
+		resp.ConsensusTimestamp = &services.Timestamp{Seconds: int64(sequenceNumber)}
+		return resp
+	}
+
+	topicID := TopicID{Topic: 5}
+	store := NewMemoryCheckpointStore()
+
+	// the subscription ends between the chunks of a message, after a later single chunk message
+	client, server := NewMockClientAndServer([][]interface{}{
+		{_MockTopicChunk(1, 1, 2, 1, "hello "), single(2)},
+	})
+	defer server.Close()
+
+	query := NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetCheckpointStore(store)
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 1)
+
+	checkpoint, err := store.Load(topicID)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, uint64(0), checkpoint.SequenceNumber)
+	assert.True(t, checkpoint.ConsensusTimestamp.Equal(time.Unix(1, 0).Add(-time.Nanosecond)))
+
+	// resuming from the checkpoint receives the first chunk again, so the message completes
+	resumed, resumedServer := NewMockClientAndServer([][]interface{}{
+		{_MockTopicChunk(1, 1, 2, 1, "hello "), single(2), _MockTopicChunk(1, 2, 2, 3, "world"), single(4)},
+	})
+	defer resumedServer.Close()
+
+	messages = _SubscribeAndWait(t, resumed, NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetCheckpointStore(store))
+	require.Len(t, messages, 3)
+	assert.Equal(t, "hello world", string(messages[1].Contents))
+
+	checkpoint, err = store.Load(topicID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), checkpoint.SequenceNumber)
+	assert.True(t, checkpoint.ConsensusTimestamp.Equal(time.Unix(4, 0)))
+}
+
+func TestUnitTopicMessageQueryGapHandler(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{
+		{_MockTopicResponse(1), _MockTopicResponse(1), _MockTopicResponse(4)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var expected, received uint64
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 5}).
+		SetGapHandler(func(e uint64, r uint64) {
+			expected, received = e, r
+		})
+
+	messages := _SubscribeAndWait(t, client, query)
+	require.Len(t, messages, 2)
+	assert.Equal(t, uint64(2), expected)
+	assert.Equal(t, uint64(4), received)
+}
+
+func TestUnitFileCheckpointStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	topicID := TopicID{Topic: 7}
+
+	checkpoint, err := NewFileCheckpointStore(path).Load(topicID)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	timestamp := time.Unix(1700000000, 123456789)
+	err = NewFileCheckpointStore(path).Save(TopicCheckpoint{
+		TopicID:            topicID,
+		ConsensusTimestamp: timestamp,
+		SequenceNumber:     42,
+	})
+	require.NoError(t, err)
+
+	checkpoint, err = NewFileCheckpointStore(path).Load(topicID)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, uint64(42), checkpoint.SequenceNumber)
+	assert.True(t, checkpoint.ConsensusTimestamp.Equal(timestamp))
+}
+
+func TestUnitFileCheckpointStoreSaveInterval(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	topicID := TopicID{Topic: 7}
+	store := NewFileCheckpointStore(path).SetSaveInterval(time.Hour)
+
+	for i := uint64(1); i <= 3; i++ {
+		err := store.Save(TopicCheckpoint{TopicID: topicID, ConsensusTimestamp: time.Unix(1700000000, int64(i)), SequenceNumber: i})
+		require.NoError(t, err)
+	}
+
+	checkpoint, err := NewFileCheckpointStore(path).Load(topicID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), checkpoint.SequenceNumber)
+
+	checkpoint, err = store.Load(topicID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), checkpoint.SequenceNumber)
+
+	require.NoError(t, store.Flush())
+	checkpoint, err = NewFileCheckpointStore(path).Load(topicID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), checkpoint.SequenceNumber)
+}
@@ -294,6 +294,18 @@ func (tx *TopicMessageSubmitTransaction) SetLogLevel(level LogLevel) *TopicMessa
 	return tx
 }
 
+// Validate runs local sanity checks against the TopicMessageSubmitTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TopicMessageSubmitTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TopicMessageSubmitTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TopicMessageSubmitTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TopicMessageSubmitTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	chunks := uint64((len(tx.message) + (chunkSize - 1)) / chunkSize)
 	if chunks > 1 {
@@ -24,7 +24,9 @@ import (
 	"bytes"
 	"crypto/sha512"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -48,8 +50,30 @@ type TransactionInterface interface {
 	buildScheduled() (*services.SchedulableTransactionBody, error)
 	preFreezeWith(*Client)
 	regenerateID(*Client) bool
+	validateLocal() []ValidationFinding
 }
 
+// TransactionSizeLimit is the largest signed transaction, in bytes, the network accepts before responding with
+// TRANSACTION_OVERSIZE
+const TransactionSizeLimit = 6144
+
+// TransactionSizeWarning is passed to the hook set with Client.SetTransactionSizeWarning when a transaction
+// about to be executed is close to TransactionSizeLimit.
+type TransactionSizeWarning struct {
+	TransactionID TransactionID
+	// The name of the transaction type, e.g. TransferTransaction
+	Name string
+	// The size in bytes of the largest signed transaction sent to any node
+	Size  int
+	Limit int
+}
+
+const (
+	maxTransactionMemoBytes    = 100
+	minTransactionValidSeconds = 15
+	maxTransactionValidSeconds = 180
+)
+
 // Transaction is base struct for all transactions that may be built and submitted to Hedera.
 type Transaction struct {
 	executable
@@ -440,6 +464,186 @@ func (tx *Transaction) GetTransactionHashPerNode() (map[AccountID][]byte, error)
 	return transactionHash, nil
 }
 
+// GetSize returns the size in bytes of the largest signed transaction this transaction will send to any
+// of its nodes, including every signature added so far. Requires the transaction to be frozen.
+func (tx *Transaction) GetSize() (int, error) {
+	sizes, err := tx.GetSizePerNode()
+	if err != nil {
+		return 0, err
+	}
+
+	return _MaxSize(sizes), nil
+}
+
+// GetSizePerNode returns the size in bytes of the signed transaction sent to each node, including every
+// signature added so far. Requires the transaction to be frozen.
+func (tx *Transaction) GetSizePerNode() (map[AccountID]int, error) {
+	sizes := make(map[AccountID]int)
+	if !tx.IsFrozen() {
+		return sizes, errTransactionIsNotFrozen
+	}
+
+	for i, node := range tx.nodeAccountIDs.slice {
+		if i >= tx.signedTransactions._Length() {
+			break
+		}
+		signedTx := tx.signedTransactions._Get(i).(*services.SignedTransaction)
+		sizes[node.(AccountID)] = _SignedTransactionSize(signedTx.GetBodyBytes(), tx._ProjectedSignaturePairs(signedTx, 0))
+	}
+
+	return sizes, nil
+}
+
+// estimateSize projects the size of the largest signed transaction assuming it ends up carrying
+// signatureCount signatures. Signatures which are not known yet are sized as ECDSA(secp256k1)
+// signatures, the largest kind, so the estimate is an upper bound.
+func (tx *Transaction) estimateSize(e TransactionInterface, signatureCount int) (int, error) {
+	if tx.IsFrozen() {
+		largest := 0
+		for i := 0; i < tx.signedTransactions._Length(); i++ {
+			signedTx := tx.signedTransactions._Get(i).(*services.SignedTransaction)
+			size := _SignedTransactionSize(signedTx.GetBodyBytes(), tx._ProjectedSignaturePairs(signedTx, signatureCount))
+			if size > largest {
+				largest = size
+			}
+		}
+		return largest, nil
+	}
+
+	body := e.build()
+	if body.TransactionFee == 0 {
+		body.TransactionFee = tx.defaultMaxTransactionFee
+	}
+	if tx.transactionIDs._Length() > 0 {
+		body.TransactionID = tx.transactionIDs._GetCurrent().(TransactionID)._ToProtobuf()
+	} else {
+		// the payer isn't known yet, assume a large account number so the estimate stays an upper bound
+		body.TransactionID = TransactionIDGenerate(AccountID{Account: math.MaxUint32})._ToProtobuf()
+	}
+
+	nodeAccountIDs := tx.GetNodeAccountIDs()
+	if len(nodeAccountIDs) == 0 {
+		nodeAccountIDs = []AccountID{{Account: 3}}
+	}
+
+	largest := 0
+	for _, nodeAccountID := range nodeAccountIDs {
+		body.NodeAccountID = nodeAccountID._ToProtobuf()
+		bodyBytes, err := protobuf.Marshal(body)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to serialize transaction body")
+		}
+
+		size := _SignedTransactionSize(bodyBytes, tx._ProjectedSignaturePairs(nil, signatureCount))
+		if size > largest {
+			largest = size
+		}
+	}
+
+	return largest, nil
+}
+
+// _ProjectedSignaturePairs returns the signature pairs a signed transaction will carry once it is built: the
+// ones already present, one for every pending signer and placeholders until there are at least signatureCount.
+func (tx *Transaction) _ProjectedSignaturePairs(signedTx *services.SignedTransaction, signatureCount int) []*services.SignaturePair {
+	pairs := make([]*services.SignaturePair, 0)
+	prefixes := make(map[string]bool)
+
+	for _, pair := range signedTx.GetSigMap().GetSigPair() {
+		pairs = append(pairs, pair)
+		prefixes[string(pair.GetPubKeyPrefix())] = true
+	}
+
+	for i, publicKey := range tx.publicKeys {
+		if tx.transactionSigners[i] == nil {
+			continue
+		}
+		pair := publicKey._ToSignaturePairProtobuf(make([]byte, 64))
+		if prefixes[string(pair.GetPubKeyPrefix())] {
+			continue
+		}
+		pairs = append(pairs, pair)
+		prefixes[string(pair.GetPubKeyPrefix())] = true
+	}
+
+	for len(pairs) < signatureCount {
+		pairs = append(pairs, &services.SignaturePair{
+			PubKeyPrefix: make([]byte, 33),
+			Signature: &services.SignaturePair_ECDSASecp256K1{
+				ECDSASecp256K1: make([]byte, 64),
+			},
+		})
+	}
+
+	return pairs
+}
+
+// _ProjectedSignatureCount returns the number of signatures the transaction is expected to carry, at least one
+// for the payer
+func (tx *Transaction) _ProjectedSignatureCount() int {
+	var signedTx *services.SignedTransaction
+	if tx.IsFrozen() {
+		signedTx = tx.signedTransactions._Get(0).(*services.SignedTransaction)
+	}
+
+	count := len(tx._ProjectedSignaturePairs(signedTx, 0))
+	if count == 0 {
+		return 1
+	}
+
+	return count
+}
+
+func _SignedTransactionSize(bodyBytes []byte, pairs []*services.SignaturePair) int {
+	signedTransactionBytes, _ := protobuf.Marshal(&services.SignedTransaction{
+		BodyBytes: bodyBytes,
+		SigMap: &services.SignatureMap{
+			SigPair: pairs,
+		},
+	})
+
+	return protobuf.Size(&services.Transaction{
+		SignedTransactionBytes: signedTransactionBytes,
+	})
+}
+
+func _MaxSize(sizes map[AccountID]int) int {
+	largest := 0
+	for _, size := range sizes {
+		if size > largest {
+			largest = size
+		}
+	}
+
+	return largest
+}
+
+// _WarnOnSize reports the transaction to the client's size warning hook if it is close to the network limit
+func (tx *Transaction) _WarnOnSize(client *Client, e TransactionInterface) {
+	if client.transactionSizeWarningThreshold <= 0 {
+		return
+	}
+
+	size, err := tx.GetSize()
+	if err != nil || size < client.transactionSizeWarningThreshold {
+		return
+	}
+
+	warning := TransactionSizeWarning{
+		TransactionID: tx.GetTransactionID(),
+		Name:          e.getName(),
+		Size:          size,
+		Limit:         TransactionSizeLimit,
+	}
+
+	client.logger.Warn("transaction is close to the network size limit",
+		"transaction", warning.Name, "size", warning.Size, "limit", warning.Limit)
+
+	if client.transactionSizeWarningHook != nil {
+		client.transactionSizeWarningHook(warning)
+	}
+}
+
 // Sets the maxTransaction fee based on priority:
 // 1. Explicitly set for this Transaction
 // 2. Client has a default value set for all transactions
@@ -4821,6 +5025,80 @@ func (tx *Transaction) preFreezeWith(*Client) {
 	// NO-OP
 }
 
+// Building empty object as "default" implementation. Transactions with body specific limits override this.
+func (tx *Transaction) validateLocal() []ValidationFinding {
+	return nil
+}
+
+// validate runs the checks shared by every transaction followed by the body specific checks of e
+func (tx *Transaction) validate(e TransactionInterface) error {
+	findings := make([]ValidationFinding, 0)
+
+	if len(tx.memo) > maxTransactionMemoBytes {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusMemoTooLong,
+			Message: fmt.Sprintf("transaction memo is %d bytes, the maximum is %d", len(tx.memo), maxTransactionMemoBytes),
+		})
+	}
+
+	if strings.ContainsRune(tx.memo, 0) {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusInvalidZeroByteInString,
+			Message: "transaction memo contains a zero byte",
+		})
+	}
+
+	duration := tx.GetTransactionValidDuration()
+	if duration < minTransactionValidSeconds*time.Second || duration > maxTransactionValidSeconds*time.Second {
+		findings = append(findings, ValidationFinding{
+			Status: StatusInvalidTransactionDuration,
+			Message: fmt.Sprintf("transaction valid duration is %s, it must be between %ds and %ds",
+				duration, minTransactionValidSeconds, maxTransactionValidSeconds),
+		})
+	}
+
+	findings = append(findings, e.validateLocal()...)
+
+	if len(findings) > 0 {
+		return ErrLocalValidation{Findings: findings}
+	}
+
+	return nil
+}
+
+// Lint returns advisory findings about the transaction which do not make it invalid on their own but are
+// likely to make the network reject it depending on when it is submitted, such as an expired or far future
+// transaction ID. Unlike Validate, Lint never fails and is never run by FreezeWith.
+func (tx *Transaction) Lint() []ValidationFinding {
+	findings := make([]ValidationFinding, 0)
+
+	if tx.transactionIDs._Length() == 0 {
+		return findings
+	}
+
+	validStart := tx.GetTransactionID().ValidStart
+	if validStart == nil {
+		return findings
+	}
+
+	now := time.Now()
+	if validStart.Add(tx.GetTransactionValidDuration()).Before(now) {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusTransactionExpired,
+			Message: fmt.Sprintf("transaction valid start %s plus its valid duration is in the past", validStart.UTC().Format(time.RFC3339Nano)),
+		})
+	}
+
+	if validStart.After(now.Add(maxTransactionValidSeconds * time.Second)) {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusInvalidTransactionStart,
+			Message: fmt.Sprintf("transaction valid start %s is more than %ds in the future", validStart.UTC().Format(time.RFC3339Nano), maxTransactionValidSeconds),
+		})
+	}
+
+	return findings
+}
+
 func (tx *Transaction) isTransaction() bool {
 	return true
 }
@@ -4866,6 +5144,8 @@ func (tx *Transaction) execute(client *Client, e TransactionInterface) (Transact
 		tx.grpcDeadline = client.requestTimeout
 	}
 
+	tx._WarnOnSize(client, e)
+
 	resp, err := _Execute(client, e)
 
 	if err != nil {
@@ -4900,6 +5180,13 @@ func (tx *Transaction) freezeWith(client *Client, e TransactionInterface) (Trans
 	if err != nil {
 		return &Transaction{}, err
 	}
+
+	if client != nil && client.autoValidateTransactions {
+		if err := tx.validate(e); err != nil {
+			return &Transaction{}, err
+		}
+	}
+
 	body := e.build()
 
 	return tx, _TransactionFreezeWith(tx, client, body)
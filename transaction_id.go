@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -84,6 +85,41 @@ func (id TransactionID) GetRecord(client *Client) (TransactionRecord, error) {
 		Execute(client)
 }
 
+// GetRecordFromMirror builds the record corresponding to the TransactionID's transaction from the mirror node,
+// including its children. Unlike GetRecord it also works once the record is no longer cached by the
+// consensus nodes, but the record lacks the fields the mirror node doesn't report, such as the contract call
+// result and the expected token decimals. If the status of the record's receipt is exceptional an
+// ErrHederaRecordStatus will be returned alongside the record.
+func (id TransactionID) GetRecordFromMirror(client *Client) (TransactionRecord, error) {
+	if client == nil {
+		return TransactionRecord{}, errNoClientProvided
+	}
+
+	transactions, err := MirrorClientFromClient(client).GetTransactions(context.Background(), id.String())
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	record, err := _TransactionRecordFromMirror(id, transactions)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	if record.Receipt.Status != StatusSuccess {
+		recordErr := ErrHederaRecordStatus{TxID: id, Status: record.Receipt.Status}
+		if record.Receipt.Status == StatusContractRevertExecuted {
+			// the revert is best effort, the record stands on its own without it
+			if result, err := MirrorClientFromClient(client).GetContractResult(context.Background(), id.String()); err == nil {
+				callResult, _ := _MirrorHexDecode(result.CallResult)
+				recordErr.Revert = _ContractRevertFromMessage(result.ErrorMessage, callResult)
+			}
+		}
+		return record, recordErr
+	}
+
+	return record, nil
+}
+
 // String returns a string representation of the TransactionID in `AccountID@ValidStartSeconds.ValidStartNanos?scheduled_bool/nonce` format
 func (id TransactionID) String() string {
 	var pb *services.Timestamp
@@ -24,8 +24,11 @@ package hedera
  */
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -102,3 +105,86 @@ func TestUnitConcurrentTransactionIDsAreUnique(t *testing.T) {
 
 	require.Equal(t, len(seen), numOfTxns)
 }
+
+func TestUnitTransactionIDGetRecordFromMirror(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/transactions/0.0.2-1700000000-000000123", r.URL.Path)
+		_, _ = w.Write([]byte(`{"transactions":[
+			{"consensus_timestamp":"1700000005.000000001","charged_tx_fee":100,"memo_base64":"bWVtbw==","name":"TOKENMINT","entity_id":"0.0.7","nonce":0,"scheduled":false,"result":"SUCCESS",
+			 "transfers":[{"account":"0.0.2","amount":-100},{"account":"0.0.3","amount":100}],
+			 "token_transfers":[{"token_id":"0.0.8","account":"0.0.2","amount":-5,"is_approval":false},{"token_id":"0.0.8","account":"0.0.4","amount":5,"is_approval":true}],
+			 "nft_transfers":[{"token_id":"0.0.7","sender_account_id":null,"receiver_account_id":"0.0.2","serial_number":1,"is_approval":false}],
+			 "assessed_custom_fees":[{"amount":1,"collector_account_id":"0.0.9","effective_payer_account_ids":["0.0.2"],"token_id":"0.0.8"}],
+			 "staking_reward_transfers":[{"account":"0.0.2","amount":50}]},
+			{"consensus_timestamp":"1700000005.000000002","parent_consensus_timestamp":"1700000005.000000001","name":"CRYPTOCREATEACCOUNT","entity_id":"0.0.10","nonce":1,"scheduled":false,"result":"SUCCESS"},
+			{"consensus_timestamp":"1700000006.000000000","name":"CRYPTOTRANSFER","nonce":0,"scheduled":true,"result":"SUCCESS"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	transactionID := NewTransactionIDWithValidStart(AccountID{Account: 2}, time.Unix(1700000000, 123))
+	record, err := transactionID.GetRecordFromMirror(client)
+	require.NoError(t, err)
+
+	require.Equal(t, StatusSuccess, record.Receipt.Status)
+	require.Equal(t, []int64{1}, record.Receipt.SerialNumbers)
+	require.Equal(t, time.Unix(1700000005, 1), record.ConsensusTimestamp)
+	require.Equal(t, "memo", record.TransactionMemo)
+	require.Equal(t, HbarFromTinybar(100), record.TransactionFee)
+	require.Len(t, record.Transfers, 2)
+	require.Len(t, record.TokenTransfers[TokenID{Token: 8}], 2)
+	require.True(t, record.TokenTransfers[TokenID{Token: 8}][1].IsApproved)
+	require.Equal(t, AccountID{Account: 2}, record.NftTransfers[TokenID{Token: 7}][0].ReceiverAccountID)
+	require.Equal(t, AccountID{Account: 9}, *record.AssessedCustomFees[0].FeeCollectorAccountId)
+	require.Equal(t, HbarFromTinybar(50), record.PaidStakingRewards[AccountID{Account: 2}])
+
+	require.Len(t, record.Children, 1)
+	require.Equal(t, AccountID{Account: 10}, *record.Children[0].Receipt.AccountID)
+	require.Equal(t, int32(1), *record.Children[0].TransactionID.Nonce)
+	require.Equal(t, time.Unix(1700000005, 1), record.Children[0].ParentConsensusTimestamp)
+
+	scheduled, err := transactionID.SetScheduled(true).GetRecordFromMirror(client)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000006, 0), scheduled.ConsensusTimestamp)
+	require.Empty(t, scheduled.Children)
+}
+
+func TestUnitTransactionIDGetRecordFromMirrorSkipsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"transactions":[
+			{"consensus_timestamp":"1700000005.000000001","charged_tx_fee":7,"name":"CRYPTOTRANSFER","nonce":0,"scheduled":false,"result":"DUPLICATE_TRANSACTION","transfers":[{"account":"0.0.2","amount":-7},{"account":"0.0.3","amount":7}]},
+			{"consensus_timestamp":"1700000005.000000002","charged_tx_fee":100,"name":"CRYPTOTRANSFER","nonce":0,"scheduled":false,"result":"SUCCESS","transfers":[{"account":"0.0.2","amount":-100},{"account":"0.0.3","amount":100}]},
+			{"consensus_timestamp":"1700000005.000000003","charged_tx_fee":7,"name":"CRYPTOTRANSFER","nonce":0,"scheduled":false,"result":"DUPLICATE_TRANSACTION","transfers":[{"account":"0.0.2","amount":-7},{"account":"0.0.3","amount":7}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorRestURL(server.URL)
+
+	transactionID := NewTransactionIDWithValidStart(AccountID{Account: 2}, time.Unix(1700000000, 123))
+	record, err := transactionID.GetRecordFromMirror(client)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, record.Receipt.Status)
+	require.Equal(t, HbarFromTinybar(100), record.TransactionFee)
+	require.Equal(t, time.Unix(1700000005, 2), record.ConsensusTimestamp)
+	require.Empty(t, record.Children)
+}
+
+func TestUnitTransactionResponseIsRecordNotFound(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, _IsRecordNotFound(ErrHederaReceiptStatus{Status: StatusRecordNotFound}))
+	require.True(t, _IsRecordNotFound(ErrHederaPreCheckStatus{Status: StatusReceiptNotFound}))
+	require.False(t, _IsRecordNotFound(ErrHederaReceiptStatus{Status: StatusInvalidSignature}))
+	require.False(t, _IsRecordNotFound(errNoClientProvided))
+}
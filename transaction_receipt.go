@@ -301,3 +301,52 @@ func TransactionReceiptFromBytes(data []byte) (TransactionReceipt, error) {
 
 	return _TransactionReceiptFromProtobuf(&pb, nil), nil
 }
+
+// _SetEntityFromMirror sets the ID of the entity a transaction created from the transaction name
+// and entity ID reported by the mirror node
+func (receipt *TransactionReceipt) _SetEntityFromMirror(name string, entityID string) error {
+	if entityID == "" {
+		return nil
+	}
+
+	switch name {
+	case "CRYPTOCREATEACCOUNT":
+		accountID, err := AccountIDFromString(entityID)
+		if err != nil {
+			return err
+		}
+		receipt.AccountID = &accountID
+	case "CONTRACTCREATEINSTANCE":
+		contractID, err := ContractIDFromString(entityID)
+		if err != nil {
+			return err
+		}
+		receipt.ContractID = &contractID
+	case "FILECREATE":
+		fileID, err := FileIDFromString(entityID)
+		if err != nil {
+			return err
+		}
+		receipt.FileID = &fileID
+	case "CONSENSUSCREATETOPIC":
+		topicID, err := TopicIDFromString(entityID)
+		if err != nil {
+			return err
+		}
+		receipt.TopicID = &topicID
+	case "TOKENCREATION":
+		tokenID, err := TokenIDFromString(entityID)
+		if err != nil {
+			return err
+		}
+		receipt.TokenID = &tokenID
+	case "SCHEDULECREATE":
+		scheduleID, err := ScheduleIDFromString(entityID)
+		if err != nil {
+			return err
+		}
+		receipt.ScheduleID = &scheduleID
+	}
+
+	return nil
+}
@@ -519,7 +519,13 @@ func (record TransactionRecord) _ToProtobuf() (*services.TransactionGetRecordRes
 
 // Validate checks that the receipt status is Success
 func (record TransactionRecord) ValidateReceiptStatus(shouldValidate bool) error {
-	return record.Receipt.ValidateStatus(shouldValidate)
+	err := record.Receipt.ValidateStatus(shouldValidate)
+	if receiptErr, ok := err.(ErrHederaReceiptStatus); ok && record.CallResult != nil {
+		receiptErr.Revert = record.CallResult.GetRevertError()
+		return receiptErr
+	}
+
+	return err
 }
 
 // ToBytes returns the serialized bytes of a TransactionRecord
@@ -549,3 +555,184 @@ func TransactionRecordFromBytes(data []byte) (TransactionRecord, error) {
 
 	return _TransactionRecordFromProtobuf(&pb, nil), nil
 }
+
+// _TransactionRecordFromMirror builds the record of transactionID from the transactions the mirror
+// node returned for it, which include its children and the scheduled transaction it triggered
+func _TransactionRecordFromMirror(transactionID TransactionID, transactions []MirrorTransaction) (TransactionRecord, error) {
+	var nonce int32
+	if transactionID.Nonce != nil {
+		nonce = *transactionID.Nonce
+	}
+
+	var parent *MirrorTransaction
+	children := make([]MirrorTransaction, 0)
+	for i, transaction := range transactions {
+		if transaction.Scheduled != transactionID.scheduled {
+			continue
+		}
+		if transaction.Nonce == nonce {
+			// Duplicate submissions are listed under the same ID and nonce, prefer the one which was handled
+			if parent == nil || (parent.GetStatus() == StatusDuplicateTransaction && transaction.GetStatus() != StatusDuplicateTransaction) {
+				parent = &transactions[i]
+			}
+		} else if nonce == 0 {
+			children = append(children, transaction)
+		}
+	}
+	if parent == nil {
+		return TransactionRecord{}, ErrHederaRecordStatus{TxID: transactionID, Status: StatusRecordNotFound}
+	}
+
+	record, err := _TransactionRecordFromMirrorTransaction(*parent)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+	record.TransactionID = transactionID
+	record.Receipt.TransactionID = &transactionID
+
+	for _, transaction := range children {
+		child, err := _TransactionRecordFromMirrorTransaction(transaction)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		childNonce := transaction.Nonce
+		child.TransactionID = TransactionID{
+			AccountID:  transactionID.AccountID,
+			ValidStart: transactionID.ValidStart,
+			scheduled:  transactionID.scheduled,
+			Nonce:      &childNonce,
+		}
+		childID := child.TransactionID
+		child.Receipt.TransactionID = &childID
+		record.Children = append(record.Children, child)
+		record.Receipt.Children = append(record.Receipt.Children, child.Receipt)
+	}
+
+	return record, nil
+}
+
+func _TransactionRecordFromMirrorTransaction(transaction MirrorTransaction) (TransactionRecord, error) {
+	consensusTimestamp, err := transaction.ConsensusTimestamp.Time()
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+	parentConsensusTimestamp, err := transaction.ParentConsensusTimestamp.Time()
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	record := TransactionRecord{
+		Receipt: TransactionReceipt{
+			Status: transaction.GetStatus(),
+		},
+		TransactionHash:          transaction.TransactionHash,
+		ConsensusTimestamp:       consensusTimestamp,
+		TransactionMemo:          transaction.GetMemo(),
+		TransactionFee:           HbarFromTinybar(transaction.ChargedTxFee),
+		Transfers:                make([]Transfer, 0),
+		TokenTransfers:           make(map[TokenID][]TokenTransfer),
+		NftTransfers:             make(map[TokenID][]TokenNftTransfer),
+		ExpectedDecimals:         make(map[TokenID]uint32),
+		AssessedCustomFees:       make([]AssessedCustomFee, 0),
+		ParentConsensusTimestamp: parentConsensusTimestamp,
+		PaidStakingRewards:       make(map[AccountID]Hbar),
+	}
+
+	if err := record.Receipt._SetEntityFromMirror(transaction.Name, transaction.EntityID); err != nil {
+		return TransactionRecord{}, err
+	}
+
+	for _, transfer := range transaction.Transfers {
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.Transfers = append(record.Transfers, Transfer{
+			AccountID:  accountID,
+			Amount:     HbarFromTinybar(transfer.Amount),
+			IsApproved: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.TokenTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.TokenTransfers[tokenID] = append(record.TokenTransfers[tokenID], TokenTransfer{
+			AccountID:  accountID,
+			Amount:     transfer.Amount,
+			IsApproved: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.NftTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		nftTransfer := TokenNftTransfer{
+			SerialNumber: transfer.SerialNumber,
+			IsApproved:   transfer.IsApproval,
+		}
+		// Mints have no sender and burns and wipes no receiver
+		if transfer.SenderAccountID != "" {
+			if nftTransfer.SenderAccountID, err = AccountIDFromString(transfer.SenderAccountID); err != nil {
+				return TransactionRecord{}, err
+			}
+		}
+		if transfer.ReceiverAccountID != "" {
+			if nftTransfer.ReceiverAccountID, err = AccountIDFromString(transfer.ReceiverAccountID); err != nil {
+				return TransactionRecord{}, err
+			}
+		}
+		record.NftTransfers[tokenID] = append(record.NftTransfers[tokenID], nftTransfer)
+
+		if transaction.Name == "TOKENMINT" && transfer.SenderAccountID == "" {
+			record.Receipt.SerialNumbers = append(record.Receipt.SerialNumbers, transfer.SerialNumber)
+		}
+	}
+
+	for _, fee := range transaction.AssessedCustomFees {
+		assessedFee := AssessedCustomFee{
+			Amount:          fee.Amount,
+			PayerAccountIDs: make([]*AccountID, 0),
+		}
+		if fee.TokenID != "" {
+			tokenID, err := TokenIDFromString(fee.TokenID)
+			if err != nil {
+				return TransactionRecord{}, err
+			}
+			assessedFee.TokenID = &tokenID
+		}
+		if fee.CollectorAccountID != "" {
+			collectorID, err := AccountIDFromString(fee.CollectorAccountID)
+			if err != nil {
+				return TransactionRecord{}, err
+			}
+			assessedFee.FeeCollectorAccountId = &collectorID
+		}
+		for _, payer := range fee.EffectivePayerAccountIDs {
+			payerID, err := AccountIDFromString(payer)
+			if err != nil {
+				return TransactionRecord{}, err
+			}
+			assessedFee.PayerAccountIDs = append(assessedFee.PayerAccountIDs, &payerID)
+		}
+		record.AssessedCustomFees = append(record.AssessedCustomFees, assessedFee)
+	}
+
+	for _, reward := range transaction.StakingRewardTransfers {
+		accountID, err := AccountIDFromString(reward.Account)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.PaidStakingRewards[accountID] = HbarFromTinybar(reward.Amount)
+	}
+
+	return record, nil
+}
@@ -199,11 +199,23 @@ func (q *TransactionRecordQuery) mapStatusError(_ Executable, response interface
 		}
 	}
 
-	return ErrHederaReceiptStatus{
+	err := ErrHederaReceiptStatus{
 		Status: Status(query.GetTransactionGetRecord().GetTransactionRecord().GetReceipt().GetStatus()),
 		// TxID:    _TransactionIDFromProtobuf(_Request.Query.pb.GetTransactionGetRecord().TransactionID, networkName),
 		Receipt: _TransactionReceiptFromProtobuf(query.GetTransactionGetReceipt(), nil),
 	}
+	if err.Status == StatusContractRevertExecuted {
+		record := query.GetTransactionGetRecord().GetTransactionRecord()
+		functionResult := record.GetContractCallResult()
+		if functionResult == nil {
+			functionResult = record.GetContractCreateResult()
+		}
+		if functionResult != nil {
+			err.Revert = _ContractFunctionResultFromProtobuf(functionResult).GetRevertError()
+		}
+	}
+
+	return err
 }
 
 func (q *TransactionRecordQuery) getName() string {
@@ -2,6 +2,7 @@ package hedera
 
 import (
 	"encoding/hex"
+	"errors"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -37,6 +38,7 @@ type TransactionResponse struct {
 	NodeID                 AccountID
 	Hash                   []byte
 	ValidateStatus         bool
+	MirrorRecordFallback   bool
 }
 
 // MarshalJSON returns the JSON representation of the TransactionResponse.
@@ -64,7 +66,8 @@ func (response TransactionResponse) GetReceipt(client *Client) (TransactionRecei
 	return receipt, receipt.ValidateStatus(response.ValidateStatus)
 }
 
-// GetRecord retrieves the record for the transaction
+// GetRecord retrieves the record for the transaction. With MirrorRecordFallback set, the record is fetched from
+// the mirror node instead once the node no longer has the receipt or record.
 func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
@@ -72,14 +75,40 @@ func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord
 		Execute(client)
 
 	if err != nil {
+		if response.MirrorRecordFallback && _IsRecordNotFound(err) {
+			return response.TransactionID.GetRecordFromMirror(client)
+		}
 		// Manually add the receipt, because an empty TransactionRecord will have an empty receipt and empty receipt has no status and no status defaults to 0, which means success
 		return TransactionRecord{Receipt: receipt}, err
 	}
 
-	return NewTransactionRecordQuery().
+	record, err := NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
 		Execute(client)
+
+	if err != nil && response.MirrorRecordFallback && _IsRecordNotFound(err) {
+		return response.TransactionID.GetRecordFromMirror(client)
+	}
+
+	return record, err
+}
+
+// _IsRecordNotFound reports whether err means the node no longer has the receipt or record cached
+func _IsRecordNotFound(err error) bool {
+	var status Status
+	var precheckErr ErrHederaPreCheckStatus
+	var receiptErr ErrHederaReceiptStatus
+	switch {
+	case errors.As(err, &precheckErr):
+		status = precheckErr.Status
+	case errors.As(err, &receiptErr):
+		status = receiptErr.Status
+	default:
+		return false
+	}
+
+	return status == StatusRecordNotFound || status == StatusReceiptNotFound
 }
 
 // GetReceiptQuery retrieves the receipt query for the transaction
@@ -102,6 +131,18 @@ func (response TransactionResponse) SetValidateStatus(validate bool) *Transactio
 	return &response
 }
 
+// SetMirrorRecordFallback sets whether GetRecord falls back to the mirror node when the node
+// answers RECORD_NOT_FOUND or RECEIPT_NOT_FOUND, as it only caches records for a few minutes
+func (response TransactionResponse) SetMirrorRecordFallback(fallback bool) *TransactionResponse {
+	response.MirrorRecordFallback = fallback
+	return &response
+}
+
+// GetMirrorRecordFallback returns whether GetRecord falls back to the mirror node
+func (response TransactionResponse) GetMirrorRecordFallback() bool {
+	return response.MirrorRecordFallback
+}
+
 // GetValidateStatus returns the validate status for the transaction
 func (response TransactionResponse) GetValidateStatus() bool {
 	return response.ValidateStatus
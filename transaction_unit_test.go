@@ -1005,3 +1005,74 @@ func createTransactionTests(txName string, nodeAccountIds []AccountID) []transac
 // TransactionGetTransactionHash //needs to be tested in e2e tests
 // TransactionGetTransactionHashPerNode //needs to be tested in e2e tests
 // TransactionExecute //needs to be tested in e2e tests
+
+func TestUnitTransactionGetSize(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transaction := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(testTransactionID).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 5}, HbarFromTinybar(1))
+
+	_, err = transaction.GetSize()
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+
+	estimated, err := transaction.EstimateSize(1)
+	require.NoError(t, err)
+
+	_, err = transaction.Freeze()
+	require.NoError(t, err)
+	transaction.Sign(key)
+
+	size, err := transaction.GetSize()
+	require.NoError(t, err)
+
+	built, err := transaction._BuildTransaction(0)
+	require.NoError(t, err)
+	require.Equal(t, protobuf.Size(built), size)
+
+	// the projected signature is sized as an ECDSA one, a byte larger than Ed25519
+	require.Equal(t, size+1, estimated)
+
+	sizes, err := transaction.GetSizePerNode()
+	require.NoError(t, err)
+	require.Len(t, sizes, 2)
+
+	estimatedTwo, err := transaction.EstimateSize(2)
+	require.NoError(t, err)
+	require.Greater(t, estimatedTwo, size)
+}
+
+func TestUnitTransactionSizeWarning(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	warnings := make([]TransactionSizeWarning, 0)
+	client.SetTransactionSizeWarning(100, func(warning TransactionSizeWarning) {
+		warnings = append(warnings, warning)
+	})
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 5}, HbarFromTinybar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Len(t, warnings, 1)
+	require.Equal(t, "TransferTransaction", warnings[0].Name)
+	require.Equal(t, TransactionSizeLimit, warnings[0].Limit)
+	require.GreaterOrEqual(t, warnings[0].Size, 100)
+}
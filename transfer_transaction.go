@@ -21,6 +21,8 @@ package hedera
  */
 
 import (
+	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -29,6 +31,14 @@ import (
 	"github.com/hashgraph/hedera-protobufs-go/services"
 )
 
+// Network limits on the number of entries a single CryptoTransfer may carry
+const (
+	maxHbarTransfers      = 10
+	maxTokenTransferLists = 10
+	maxTokenTransfers     = 10
+	maxNftTransfers       = 10
+)
+
 // TransferTransaction
 // Transfers cryptocurrency among two or more accounts by making the desired adjustments to their
 // balances. Each transfer list can specify up to 10 adjustments. Each negative amount is withdrawn
@@ -44,6 +54,7 @@ type TransferTransaction struct {
 	tokenTransfers map[TokenID]*_TokenTransfer
 	hbarTransfers  []*_HbarTransfer
 	nftTransfers   map[TokenID][]*TokenNftTransfer
+	aliasResolver  *AliasResolver
 }
 
 // NewTransferTransaction creates TransferTransaction which
@@ -208,6 +219,86 @@ func (tx *TransferTransaction) AddHbarTransfer(accountID AccountID, amount Hbar)
 	return tx
 }
 
+// SetAliasResolver sets the resolver used to replace the aliases and EVM addresses of the accounts of the transfers
+// by their account numbers before the transaction is frozen. Aliases without an account are kept, so that the
+// transfer creates the account.
+func (tx *TransferTransaction) SetAliasResolver(resolver *AliasResolver) *TransferTransaction {
+	tx._RequireNotFrozen()
+	tx.aliasResolver = resolver
+	return tx
+}
+
+// GetAliasResolver returns the resolver used to replace the aliases of the accounts of the transfers
+func (tx *TransferTransaction) GetAliasResolver() *AliasResolver {
+	return tx.aliasResolver
+}
+
+// _ResolveAliases replaces the aliases of the accounts of the transfers by their account numbers, merging the
+// transfers which turn out to be of the same account
+func (tx *TransferTransaction) _ResolveAliases() error {
+	if tx.aliasResolver == nil || tx.IsFrozen() {
+		return nil
+	}
+
+	accountIDs := make([]AccountID, 0)
+	setters := make([]func(AccountID), 0)
+	collect := func(accountID AccountID, set func(AccountID)) {
+		accountIDs = append(accountIDs, accountID)
+		setters = append(setters, set)
+	}
+
+	for _, transfer := range tx.hbarTransfers {
+		transfer := transfer
+		collect(*transfer.accountID, func(accountID AccountID) { transfer.accountID = &accountID })
+	}
+	for _, tokenTransfer := range tx.tokenTransfers {
+		for _, transfer := range tokenTransfer.Transfers {
+			transfer := transfer
+			collect(*transfer.accountID, func(accountID AccountID) { transfer.accountID = &accountID })
+		}
+	}
+	for _, nftTransfers := range tx.nftTransfers {
+		for _, transfer := range nftTransfers {
+			transfer := transfer
+			collect(transfer.SenderAccountID, func(accountID AccountID) { transfer.SenderAccountID = accountID })
+			collect(transfer.ReceiverAccountID, func(accountID AccountID) { transfer.ReceiverAccountID = accountID })
+		}
+	}
+
+	resolved, err := tx.aliasResolver.ResolveAccountIDs(context.Background(), accountIDs)
+	if err != nil {
+		return err
+	}
+	for i, set := range setters {
+		set(resolved[i])
+	}
+
+	tx.hbarTransfers = _MergeHbarTransfers(tx.hbarTransfers)
+	for _, tokenTransfer := range tx.tokenTransfers {
+		tokenTransfer.Transfers = _MergeHbarTransfers(tokenTransfer.Transfers)
+	}
+
+	return nil
+}
+
+// _MergeHbarTransfers sums the transfers of the same account with the same approval
+func _MergeHbarTransfers(transfers []*_HbarTransfer) []*_HbarTransfer {
+	merged := make([]*_HbarTransfer, 0, len(transfers))
+
+outer:
+	for _, transfer := range transfers {
+		for _, existing := range merged {
+			if existing.accountID.Compare(*transfer.accountID) == 0 && existing.IsApproved == transfer.IsApproved {
+				existing.Amount = HbarFromTinybar(existing.Amount.AsTinybar() + transfer.Amount.AsTinybar())
+				continue outer
+			}
+		}
+		merged = append(merged, transfer)
+	}
+
+	return merged
+}
+
 // GetTokenIDDecimals returns the token decimals
 func (tx *TransferTransaction) GetTokenIDDecimals() map[TokenID]uint32 {
 	result := make(map[TokenID]uint32)
@@ -487,6 +578,9 @@ func (tx *TransferTransaction) Freeze() (*TransferTransaction, error) {
 }
 
 func (tx *TransferTransaction) FreezeWith(client *Client) (*TransferTransaction, error) {
+	if err := tx._ResolveAliases(); err != nil {
+		return tx, err
+	}
 	_, err := tx.Transaction.freezeWith(client, tx)
 	return tx, err
 }
@@ -561,9 +655,24 @@ func (tx *TransferTransaction) SetLogLevel(level LogLevel) *TransferTransaction
 }
 
 func (tx *TransferTransaction) Execute(client *Client) (TransactionResponse, error) {
+	if err := tx._ResolveAliases(); err != nil {
+		return TransactionResponse{}, err
+	}
 	return tx.Transaction.execute(client, tx)
 }
 
+// Validate runs local sanity checks against the TransferTransaction and returns ErrLocalValidation
+// describing every check which failed.
+func (tx *TransferTransaction) Validate() error {
+	return tx.Transaction.validate(tx)
+}
+
+// EstimateSize returns the projected size in bytes of the largest signed TransferTransaction sent to any node once it
+// carries signatureCount signatures. It can be called before the transaction is frozen.
+func (tx *TransferTransaction) EstimateSize(signatureCount int) (int, error) {
+	return tx.Transaction.estimateSize(tx, signatureCount)
+}
+
 func (tx *TransferTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -617,6 +726,134 @@ func (tx *TransferTransaction) validateNetworkOnIDs(client *Client) error {
 	return nil
 }
 
+func (tx *TransferTransaction) validateLocal() []ValidationFinding {
+	findings := make([]ValidationFinding, 0)
+
+	if len(tx.hbarTransfers) > maxHbarTransfers {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusTransferListSizeLimitExceeded,
+			Message: fmt.Sprintf("%d hbar transfers, the maximum is %d", len(tx.hbarTransfers), maxHbarTransfers),
+		})
+	}
+
+	hbarSum := int64(0)
+	hbarAccounts := make(map[string]bool)
+	for _, transfer := range tx.hbarTransfers {
+		hbarSum += transfer.Amount.AsTinybar()
+		if hbarAccounts[transfer.accountID.String()] {
+			findings = append(findings, ValidationFinding{
+				Status:  StatusAccountRepeatedInAccountAmounts,
+				Message: fmt.Sprintf("account %s is repeated in the hbar transfers", transfer.accountID.String()),
+			})
+		}
+		hbarAccounts[transfer.accountID.String()] = true
+	}
+	if hbarSum != 0 {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusInvalidAccountAmounts,
+			Message: fmt.Sprintf("hbar transfers sum to %s instead of zero", HbarFromTinybar(hbarSum).String()),
+		})
+	}
+
+	tokenIDs := make([]TokenID, 0, len(tx.tokenTransfers))
+	for tokenID := range tx.tokenTransfers {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Sort(_TokenIDs{tokenIDs: tokenIDs})
+
+	tokenLists := 0
+	tokenAdjustments := 0
+	for _, tokenID := range tokenIDs {
+		tokenTransfer := tx.tokenTransfers[tokenID]
+		if len(tokenTransfer.Transfers) == 0 {
+			continue
+		}
+		tokenLists++
+		tokenAdjustments += len(tokenTransfer.Transfers)
+
+		if len(tx.nftTransfers[tokenID]) > 0 {
+			findings = append(findings, ValidationFinding{
+				Status:  StatusTokenIDRepeatedInTokenList,
+				Message: fmt.Sprintf("token %s has both fungible and NFT transfers", tokenID.String()),
+			})
+		}
+
+		tokenSum := int64(0)
+		tokenAccounts := make(map[string]bool)
+		for _, transfer := range tokenTransfer.Transfers {
+			tokenSum += transfer.Amount.AsTinybar()
+			if tokenAccounts[transfer.accountID.String()] {
+				findings = append(findings, ValidationFinding{
+					Status:  StatusAccountRepeatedInAccountAmounts,
+					Message: fmt.Sprintf("account %s is repeated in the transfers of token %s", transfer.accountID.String(), tokenID.String()),
+				})
+			}
+			tokenAccounts[transfer.accountID.String()] = true
+		}
+		if tokenSum != 0 {
+			findings = append(findings, ValidationFinding{
+				Status:  StatusTransfersNotZeroSumForToken,
+				Message: fmt.Sprintf("transfers of token %s sum to %d instead of zero", tokenID.String(), tokenSum),
+			})
+		}
+	}
+
+	nftTokenIDs := make([]TokenID, 0, len(tx.nftTransfers))
+	for tokenID := range tx.nftTransfers {
+		nftTokenIDs = append(nftTokenIDs, tokenID)
+	}
+	sort.Sort(_TokenIDs{tokenIDs: nftTokenIDs})
+
+	nftCount := 0
+	for _, tokenID := range nftTokenIDs {
+		nftTransfers := tx.nftTransfers[tokenID]
+		if len(nftTransfers) == 0 {
+			continue
+		}
+		tokenLists++
+		nftCount += len(nftTransfers)
+
+		serials := make(map[int64]bool)
+		for _, nftTransfer := range nftTransfers {
+			if serials[nftTransfer.SerialNumber] {
+				findings = append(findings, ValidationFinding{
+					Status:  StatusAccountRepeatedInAccountAmounts,
+					Message: fmt.Sprintf("NFT %d@%s is transferred more than once", nftTransfer.SerialNumber, tokenID.String()),
+				})
+			}
+			serials[nftTransfer.SerialNumber] = true
+
+			if nftTransfer.SenderAccountID.Compare(nftTransfer.ReceiverAccountID) == 0 {
+				findings = append(findings, ValidationFinding{
+					Status:  StatusAccountRepeatedInAccountAmounts,
+					Message: fmt.Sprintf("NFT %d@%s has the same sender and receiver", nftTransfer.SerialNumber, tokenID.String()),
+				})
+			}
+		}
+	}
+
+	if tokenLists > maxTokenTransferLists {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusTokenTransferListSizeLimitExceeded,
+			Message: fmt.Sprintf("%d token transfer lists, the maximum is %d", tokenLists, maxTokenTransferLists),
+		})
+	}
+	if tokenAdjustments > maxTokenTransfers {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusTokenTransferListSizeLimitExceeded,
+			Message: fmt.Sprintf("%d token transfers, the maximum is %d", tokenAdjustments, maxTokenTransfers),
+		})
+	}
+	if nftCount > maxNftTransfers {
+		findings = append(findings, ValidationFinding{
+			Status:  StatusBatchSizeLimitExceeded,
+			Message: fmt.Sprintf("%d NFT transfers, the maximum is %d", nftCount, maxNftTransfers),
+		})
+	}
+
+	return findings
+}
+
 func (tx *TransferTransaction) build() *services.TransactionBody {
 	return &services.TransactionBody{
 		TransactionFee:           tx.transactionFee,
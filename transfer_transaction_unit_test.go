@@ -222,3 +222,119 @@ func TestUnitTransferTransactionOrdered(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitTransferTransactionValidateLocal(t *testing.T) {
+	t.Parallel()
+
+	err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-10)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(10)).
+		AddTokenTransfer(TokenID{Token: 5}, AccountID{Account: 2}, -1).
+		AddTokenTransfer(TokenID{Token: 5}, AccountID{Account: 3}, 1).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6}, SerialNumber: 1}, AccountID{Account: 2}, AccountID{Account: 3}).
+		Validate()
+	require.NoError(t, err)
+}
+
+func TestUnitTransferTransactionValidateLocalFindings(t *testing.T) {
+	t.Parallel()
+
+	transfer := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-10)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(9)).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6}, SerialNumber: 1}, AccountID{Account: 2}, AccountID{Account: 3}).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6}, SerialNumber: 1}, AccountID{Account: 2}, AccountID{Account: 3}).
+		SetTransactionMemo(string(make([]byte, 101))).
+		SetTransactionValidDuration(181 * time.Second)
+	for i := 0; i < 11; i++ {
+		transfer.AddTokenTransfer(TokenID{Token: uint64(100 + i)}, AccountID{Account: 2}, 1)
+	}
+
+	err := transfer.Validate()
+	require.Error(t, err)
+
+	var validationErr ErrLocalValidation
+	require.ErrorAs(t, err, &validationErr)
+
+	statuses := make([]Status, 0)
+	for _, finding := range validationErr.Findings {
+		statuses = append(statuses, finding.Status)
+	}
+	require.Contains(t, statuses, StatusMemoTooLong)
+	require.Contains(t, statuses, StatusInvalidZeroByteInString)
+	require.Contains(t, statuses, StatusInvalidTransactionDuration)
+	require.Contains(t, statuses, StatusInvalidAccountAmounts)
+	require.Contains(t, statuses, StatusTransfersNotZeroSumForToken)
+	require.Contains(t, statuses, StatusAccountRepeatedInAccountAmounts)
+	require.Contains(t, statuses, StatusTokenTransferListSizeLimitExceeded)
+}
+
+func TestUnitTransferTransactionValidateLocalFindingsOrder(t *testing.T) {
+	t.Parallel()
+
+	transfer := NewTransferTransaction()
+	for i := 10; i > 0; i-- {
+		transfer.AddTokenTransfer(TokenID{Token: uint64(100 + i)}, AccountID{Account: 2}, 1)
+	}
+
+	err := transfer.Validate()
+	var validationErr ErrLocalValidation
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Findings, 10)
+	for i, finding := range validationErr.Findings {
+		require.Equal(t, StatusTransfersNotZeroSumForToken, finding.Status)
+		require.Contains(t, finding.Message, TokenID{Token: uint64(101 + i)}.String())
+	}
+}
+
+func TestUnitTransferTransactionLint(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetAutoValidateTransactions(true)
+
+	require.Empty(t, NewTransferTransaction().Lint())
+
+	expired := NewTransactionIDWithValidStart(AccountID{Account: 2}, time.Now().Add(-time.Hour))
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(expired).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-10)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(10)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	findings := transfer.Lint()
+	require.Len(t, findings, 1)
+	require.Equal(t, StatusTransactionExpired, findings[0].Status)
+
+	future := NewTransactionIDWithValidStart(AccountID{Account: 2}, time.Now().Add(time.Hour))
+	findings = NewTransferTransaction().SetTransactionID(future).Lint()
+	require.Len(t, findings, 1)
+	require.Equal(t, StatusInvalidTransactionStart, findings[0].Status)
+}
+
+func TestUnitTransferTransactionFreezeWithAutoValidate(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetAutoValidateTransactions(true)
+
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-10)).
+		FreezeWith(client)
+	require.ErrorAs(t, err, &ErrLocalValidation{})
+
+	client.SetAutoValidateTransactions(false)
+
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-10)).
+		FreezeWith(client)
+	require.NoError(t, err)
+}